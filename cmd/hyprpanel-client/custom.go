@@ -0,0 +1,372 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"github.com/pdf/hyprpanel/style"
+)
+
+const (
+	customDefaultInterval = 10 * time.Second
+	customKillDelay       = 2 * time.Second
+)
+
+// customOutput is a single update emitted by a custom module command.
+type customOutput struct {
+	Text       string   `json:"text"`
+	Icon       string   `json:"icon"`
+	Tooltip    string   `json:"tooltip"`
+	Class      string   `json:"class"`
+	Percentage *float64 `json:"percentage"`
+}
+
+// parseCustomOutput decodes command output as a JSON object, falling back to
+// using the first line of output as text.
+func parseCustomOutput(b []byte) *customOutput {
+	b = bytes.TrimSpace(b)
+	out := &customOutput{}
+	if len(b) > 0 && b[0] == '{' {
+		if err := json.Unmarshal(b, out); err == nil {
+			return out
+		}
+		out = &customOutput{}
+	}
+	line, _, _ := bytes.Cut(b, []byte("\n"))
+	out.Text = string(line)
+
+	return out
+}
+
+type custom struct {
+	*refTracker
+	*api
+	cfg    *modulev1.Custom
+	exec   []string
+	ctx    context.Context
+	cancel context.CancelFunc
+	quitCh chan struct{}
+	output *customOutput
+
+	container *gtk.Box
+	iconBox   *gtk.CenterBox
+	icon      *gtk.Image
+	label     *gtk.Label
+	gauge     *gtk.LevelBar
+}
+
+func (c *custom) build(container *gtk.Box) error {
+	if strings.TrimSpace(c.cfg.Command) == `` {
+		return errors.New(`custom module command must not be empty`)
+	}
+	c.exec = customShellCommand(c.cfg.Command)
+
+	c.container = gtk.NewBox(c.orientation, 0)
+	c.container.SetName(style.CustomID)
+	c.container.AddCssClass(style.ModuleClass)
+	if c.cfg.Name != `` {
+		c.container.AddCssClass(c.cfg.Name)
+	}
+
+	c.iconBox = gtk.NewCenterBox()
+	c.iconBox.AddCssClass(style.CustomIconClass)
+	c.iconBox.SetVisible(false)
+	c.container.Append(&c.iconBox.Widget)
+
+	c.label = gtk.NewLabel(``)
+	c.label.AddCssClass(style.CustomLabelClass)
+	c.label.SetVisible(false)
+	c.container.Append(&c.label.Widget)
+
+	c.gauge = gtk.NewLevelBarForInterval(0, 100)
+	c.gauge.AddCssClass(style.CustomGaugeClass)
+	if c.orientation == gtk.OrientationHorizontalValue {
+		c.gauge.SetOrientation(gtk.OrientationVerticalValue)
+		c.gauge.SetInverted(true)
+	} else {
+		c.gauge.SetOrientation(gtk.OrientationHorizontalValue)
+	}
+	c.gauge.SetVisible(false)
+	c.container.Append(&c.gauge.Widget)
+
+	clickExec := make(map[uint][]string)
+	for button, cmd := range map[uint]string{
+		uint(gdk.BUTTON_PRIMARY):   c.cfg.CommandLeftClick,
+		uint(gdk.BUTTON_MIDDLE):    c.cfg.CommandMiddleClick,
+		uint(gdk.BUTTON_SECONDARY): c.cfg.CommandRightClick,
+	} {
+		if cmd == `` {
+			continue
+		}
+		clickExec[button] = customShellCommand(cmd)
+	}
+	if len(clickExec) > 0 {
+		clickController := gtk.NewGestureClick()
+		clickController.SetButton(0)
+		clickCb := func(ctrl gtk.GestureClick, nPress int, x, y float64) {
+			if exec, ok := clickExec[ctrl.GetCurrentButton()]; ok {
+				c.launch(`click`, exec)
+			}
+		}
+		clickController.ConnectReleased(&clickCb)
+		c.container.AddController(&clickController.EventController)
+		c.AddRef(func() {
+			unrefCallback(&clickCb)
+		})
+	}
+
+	var scrollUpExec, scrollDownExec []string
+	if c.cfg.CommandScrollUp != `` {
+		scrollUpExec = customShellCommand(c.cfg.CommandScrollUp)
+	}
+	if c.cfg.CommandScrollDown != `` {
+		scrollDownExec = customShellCommand(c.cfg.CommandScrollDown)
+	}
+	if scrollUpExec != nil || scrollDownExec != nil {
+		scrollCb := func(_ gtk.EventControllerScroll, dx, dy float64) bool {
+			if dy < 0 {
+				if scrollUpExec != nil {
+					c.launch(`scroll up`, scrollUpExec)
+				}
+			} else if scrollDownExec != nil {
+				c.launch(`scroll down`, scrollDownExec)
+			}
+
+			return true
+		}
+		c.AddRef(func() {
+			unrefCallback(&scrollCb)
+		})
+
+		scrollController := gtk.NewEventControllerScroll(gtk.EventControllerScrollVerticalValue | gtk.EventControllerScrollDiscreteValue)
+		scrollController.ConnectScroll(&scrollCb)
+		c.container.AddController(&scrollController.EventController)
+	}
+
+	container.Append(&c.container.Widget)
+
+	go c.watch()
+
+	return nil
+}
+
+func (c *custom) launch(action string, exec []string) {
+	if err := c.host.Exec(&hyprpanelv1.AppInfo_Action{Name: c.cfg.Name + ` ` + action, Exec: exec}); err != nil {
+		log.Error(`Failed executing command`, `module`, style.CustomID, `name`, c.cfg.Name, `action`, action, `err`, err)
+	}
+}
+
+func (c *custom) interval() time.Duration {
+	if interval := c.cfg.Interval.AsDuration(); interval > 0 {
+		return interval
+	}
+
+	return customDefaultInterval
+}
+
+func (c *custom) command() *exec.Cmd {
+	cmd := exec.CommandContext(c.ctx, c.exec[0], c.exec[1:]...)
+	cmd.Stderr = os.Stderr
+	// Run the command in its own process group, so that children of shell
+	// scripts are terminated along with it.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM)
+	}
+	cmd.WaitDelay = customKillDelay
+
+	return cmd
+}
+
+func (c *custom) watch() {
+	interval := c.interval()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.quitCh:
+			return
+		case <-timer.C:
+			var err error
+			if c.cfg.Stream {
+				err = c.runStream()
+			} else {
+				err = c.runOnce()
+			}
+			if err != nil && c.ctx.Err() == nil {
+				log.Warn(`Custom command failed`, `module`, style.CustomID, `name`, c.cfg.Name, `err`, err)
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+func (c *custom) runOnce() error {
+	b, err := c.command().Output()
+	if err != nil {
+		return err
+	}
+	c.publish(parseCustomOutput(b))
+
+	return nil
+}
+
+func (c *custom) runStream() error {
+	cmd := c.command()
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		c.publish(parseCustomOutput(scanner.Bytes()))
+	}
+	if err := scanner.Err(); err != nil {
+		_ = cmd.Cancel()
+		_ = cmd.Wait()
+		return err
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return err
+	}
+	if c.ctx.Err() == nil {
+		log.Debug(`Custom command exited, restarting`, `module`, style.CustomID, `name`, c.cfg.Name, `delay`, c.interval())
+	}
+
+	return nil
+}
+
+func (c *custom) publish(out *customOutput) {
+	var cb glib.SourceFunc
+	cb = func(uintptr) bool {
+		defer unrefCallback(&cb)
+		select {
+		case <-c.quitCh:
+			return false
+		default:
+		}
+		if err := c.update(out); err != nil {
+			log.Warn(`Failed updating`, `module`, style.CustomID, `name`, c.cfg.Name, `err`, err)
+		}
+		return false
+	}
+
+	glib.IdleAdd(&cb, 0)
+}
+
+func (c *custom) update(out *customOutput) error {
+	prev := c.output
+	if prev == nil {
+		prev = &customOutput{}
+	}
+	c.output = out
+
+	if prev.Icon != out.Icon {
+		if c.icon != nil {
+			icon := c.icon
+			defer icon.Unref()
+			c.icon = nil
+		}
+		if out.Icon != `` {
+			var err error
+			c.icon, err = createIcon(out.Icon, int(c.cfg.IconSize), c.cfg.IconSymbolic, nil)
+			if err != nil {
+				c.iconBox.SetVisible(false)
+				return err
+			}
+			c.iconBox.SetCenterWidget(&c.icon.Widget)
+		}
+		c.iconBox.SetVisible(out.Icon != ``)
+	}
+
+	if prev.Text != out.Text {
+		c.label.SetMarkup(out.Text)
+		c.label.SetVisible(out.Text != ``)
+	}
+
+	if prev.Tooltip != out.Tooltip {
+		if out.Tooltip != `` {
+			c.container.SetTooltipMarkup(out.Tooltip)
+		} else {
+			c.container.SetHasTooltip(false)
+		}
+	}
+
+	if prev.Class != out.Class {
+		prevClasses := strings.Fields(prev.Class)
+		classes := strings.Fields(out.Class)
+		for _, class := range prevClasses {
+			if !slices.Contains(classes, class) {
+				c.container.RemoveCssClass(class)
+			}
+		}
+		for _, class := range classes {
+			c.container.AddCssClass(class)
+		}
+	}
+
+	if out.Percentage != nil {
+		c.gauge.SetValue(min(max(*out.Percentage, 0), 100))
+	}
+	c.gauge.SetVisible(out.Percentage != nil)
+
+	return nil
+}
+
+func (c *custom) close(container *gtk.Box) {
+	defer c.Unref()
+	log.Debug(`Closing module on request`, `module`, style.CustomID, `name`, c.cfg.Name)
+	container.Remove(&c.container.Widget)
+	if c.icon != nil {
+		c.icon.Unref()
+	}
+}
+
+// customShellCommand wraps cmd for execution by the shell, so that
+// substitutions, pipes and variables are evaluated every time it runs, rather
+// than once when the module is built.
+func customShellCommand(cmd string) []string {
+	return []string{`sh`, `-c`, cmd}
+}
+
+func newCustom(cfg *modulev1.Custom, a *api) *custom {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &custom{
+		refTracker: newRefTracker(),
+		api:        a,
+		cfg:        cfg,
+		ctx:        ctx,
+		cancel:     cancel,
+		quitCh:     make(chan struct{}),
+	}
+
+	c.AddRef(func() {
+		close(c.quitCh)
+		c.cancel()
+	})
+
+	return c
+}
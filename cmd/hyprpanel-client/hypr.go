@@ -0,0 +1,34 @@
+package main
+
+import (
+	"slices"
+
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	"github.com/pdf/hyprpanel/internal/panelplugin"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+)
+
+// hostHyprConn proxies Hyprland requests through the host, which caches query
+// responses for all panels.
+type hostHyprConn struct {
+	host panelplugin.Host
+}
+
+func (c *hostHyprConn) Query(query hyprpanelv1.HyprQuery) ([]byte, error) {
+	return c.host.HyprQuery(query)
+}
+
+func (c *hostHyprConn) Dispatch(args ...string) error {
+	return c.host.HyprDispatch(args...)
+}
+
+// newHyprConn returns a connection to Hyprland proxied through host, or a
+// direct connection if host predates proxying.
+func newHyprConn(host panelplugin.Host) hypripc.Conn {
+	if caps := host.Capabilities(); caps == nil || !slices.Contains(caps.Methods, hyprpanelv1.HostService_HyprQuery_FullMethodName) {
+		log.Debug(`Host does not proxy Hyprland requests, connecting directly`)
+		return hypripc.NewSocketConn(log)
+	}
+
+	return &hostHyprConn{host: host}
+}
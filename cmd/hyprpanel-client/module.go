@@ -1,8 +1,12 @@
 package main
 
 import (
+	"slices"
+
 	"github.com/jwijenbergh/puregotk/v4/gtk"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
 )
 
 type module interface {
@@ -13,3 +17,120 @@ type module interface {
 type moduleReceiver interface {
 	events() chan<- *eventv1.Event
 }
+
+var (
+	audioEventKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_AUDIO_SINK_CHANGE,
+		eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_CHANGE,
+	}
+	powerEventKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE,
+	}
+	systrayEventKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER,
+		eventv1.EventKind_EVENT_KIND_DBUS_UNREGISTERSTATUSNOTIFIER,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATETOOLTIP,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATEICON,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATESTATUS,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATEMENU,
+	}
+	pagerEventKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACEV2,
+		eventv1.EventKind_EVENT_KIND_HYPR_CREATEWORKSPACEV2,
+		eventv1.EventKind_EVENT_KIND_HYPR_DESTROYWORKSPACEV2,
+		eventv1.EventKind_EVENT_KIND_HYPR_MOVEWORKSPACEV2,
+		eventv1.EventKind_EVENT_KIND_HYPR_RENAMEWORKSPACE,
+		eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2,
+		eventv1.EventKind_EVENT_KIND_HYPR_OPENWINDOW,
+		eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW,
+		eventv1.EventKind_EVENT_KIND_HYPR_FULLSCREEN,
+		eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLE,
+		eventv1.EventKind_EVENT_KIND_HYPR_MOVEWINDOWV2,
+	}
+	taskbarEventKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACE,
+		eventv1.EventKind_EVENT_KIND_HYPR_MOVEWORKSPACE,
+		eventv1.EventKind_EVENT_KIND_HYPR_ACTIVEWINDOWV2,
+		eventv1.EventKind_EVENT_KIND_HYPR_OPENWINDOW,
+		eventv1.EventKind_EVENT_KIND_HYPR_CLOSEWINDOW,
+		eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLE,
+		eventv1.EventKind_EVENT_KIND_HYPR_MOVEWINDOW,
+	}
+	notificationsEventKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_DBUS_NOTIFICATION,
+		eventv1.EventKind_EVENT_KIND_DBUS_CLOSENOTIFICATION,
+	}
+	hudEventKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_HUD_NOTIFY,
+	}
+	idleInhibitorEventKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_IDLE_INHIBITOR_INHIBIT,
+		eventv1.EventKind_EVENT_KIND_IDLE_INHIBITOR_UNINHIBIT,
+	}
+	mediaPlayerEventKinds = []eventv1.EventKind{
+		eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE,
+	}
+)
+
+// moduleEventKinds returns the event kinds consumed by the module configured
+// by cfg, and false if the module is not known.
+func moduleEventKinds(cfg *modulev1.Module) ([]eventv1.EventKind, bool) {
+	switch cfg.Kind.(type) {
+	case *modulev1.Module_Pager:
+		return pagerEventKinds, true
+	case *modulev1.Module_Taskbar:
+		return taskbarEventKinds, true
+	case *modulev1.Module_Systray:
+		kinds := slices.Clone(systrayEventKinds)
+		for _, modCfg := range cfg.GetSystray().Modules {
+			switch modCfg.Kind.(type) {
+			case *modulev1.SystrayModule_Audio:
+				kinds = append(kinds, audioEventKinds...)
+			case *modulev1.SystrayModule_Power:
+				kinds = append(kinds, powerEventKinds...)
+			}
+		}
+		return kinds, true
+	case *modulev1.Module_Notifications:
+		return notificationsEventKinds, true
+	case *modulev1.Module_Hud:
+		return hudEventKinds, true
+	case *modulev1.Module_Audio:
+		return audioEventKinds, true
+	case *modulev1.Module_Power:
+		return powerEventKinds, true
+	case *modulev1.Module_IdleInhibitor:
+		return idleInhibitorEventKinds, true
+	case *modulev1.Module_MediaPlayer:
+		return mediaPlayerEventKinds, true
+	case *modulev1.Module_Clock, *modulev1.Module_Session, *modulev1.Module_Spacer, *modulev1.Module_Custom, *modulev1.Module_Widget:
+		return nil, true
+	default:
+		return nil, false
+	}
+}
+
+// eventFilter returns a filter for the events consumed by modules and their
+// visibility conditions, or nil if any module is not known, in which case all
+// events are required.
+func eventFilter(modules []*modulev1.Module) *hyprpanelv1.EventFilter {
+	seen := make(map[eventv1.EventKind]struct{})
+	filter := &hyprpanelv1.EventFilter{Kinds: make([]eventv1.EventKind, 0)}
+	for _, modCfg := range modules {
+		kinds, ok := moduleEventKinds(modCfg)
+		if !ok {
+			return nil
+		}
+		kinds = append(slices.Clone(kinds), visibilityEventKinds(modCfg.Visibility)...)
+		for _, kind := range kinds {
+			if _, ok := seen[kind]; ok {
+				continue
+			}
+			seen[kind] = struct{}{}
+			filter.Kinds = append(filter.Kinds, kind)
+		}
+	}
+
+	return filter
+}
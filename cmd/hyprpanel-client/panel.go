@@ -9,23 +9,30 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/jwijenbergh/puregotk/v4/gdk"
 	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
 	"github.com/jwijenbergh/puregotk/v4/gtk"
+	"github.com/pdf/hyprpanel/internal/eventqueue"
 	gtk4layershell "github.com/pdf/hyprpanel/internal/gtk4-layer-shell"
 	"github.com/pdf/hyprpanel/internal/hypripc"
 	"github.com/pdf/hyprpanel/internal/panelplugin"
 	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
 	"github.com/pdf/hyprpanel/style"
 )
 
-const appName = `com.c0dedbad.hyprpanel.client`
+const (
+	appName         = `com.c0dedbad.hyprpanel.client`
+	moduleQueueSize = 64
+)
 
 var errNotFound = errors.New(`not found`)
 
 type api struct {
+	panelID        string
 	host           panelplugin.Host
-	hypr           *hypripc.HyprIPC
+	hypr           *hypripc.Control
 	orientation    gtk.Orientation
 	currentMonitor *hypripc.Monitor
 	panelCfg       *configv1.Panel
@@ -40,17 +47,17 @@ type panel struct {
 	*refTracker
 	*api
 
-	id         string
 	stylesheet []byte
 
 	currentGDKMonitor *gdk.Monitor
+	userCSSProvider   *gtk.CssProvider
 
 	win       *gtk.Window
 	container *gtk.Box
 
 	modules   []module
 	eventCh   chan *eventv1.Event
-	receivers map[module]chan<- *eventv1.Event
+	receivers map[module]*eventqueue.Queue
 	readyCh   chan struct{}
 	quitCh    chan struct{}
 }
@@ -59,7 +66,8 @@ func (p *panel) Init(host panelplugin.Host, id string, loglevel configv1.LogLeve
 	defer close(p.readyCh)
 	log.SetLevel(hclog.Level(loglevel))
 	p.host = host
-	p.id = id
+	p.hypr = hypripc.NewControl(newHyprConn(host))
+	p.panelID = id
 	p.panelCfg = cfg
 	p.stylesheet = stylesheet
 
@@ -82,6 +90,38 @@ func (p *panel) Notify(evt *eventv1.Event) {
 	}
 }
 
+func (p *panel) NotifyBatch(evts []*eventv1.Event) {
+	for _, evt := range evts {
+		p.Notify(evt)
+	}
+}
+
+func (p *panel) EventFilter() *hyprpanelv1.EventFilter {
+	return eventFilter(p.panelCfg.Modules)
+}
+
+func (p *panel) Capabilities() *hyprpanelv1.Capabilities {
+	return panelplugin.PanelCapabilities()
+}
+
+func (p *panel) UpdateStyle(stylesheet []byte) error {
+	var cb glib.SourceFunc
+	cb = func(uintptr) bool {
+		defer unrefCallback(&cb)
+		p.stylesheet = stylesheet
+		// Before the window is initialized the stylesheet is applied on creation.
+		if p.userCSSProvider == nil {
+			return false
+		}
+		log.Debug(`Updating stylesheet`, `panelID`, p.panelID)
+		p.userCSSProvider.LoadFromData(string(p.stylesheet), len(p.stylesheet))
+		return false
+	}
+	glib.IdleAdd(&cb, 0)
+
+	return nil
+}
+
 func (p *panel) Context() context.Context {
 	return nil
 }
@@ -100,12 +140,12 @@ func (p *panel) initWindow() error {
 	defaultCSSProvider.LoadFromData(string(style.Default), len(style.Default))
 	gtk.StyleContextAddProviderForDisplay(display, defaultCSSProvider, uint(gtk.STYLE_PROVIDER_PRIORITY_APPLICATION))
 
-	if len(p.stylesheet) > 0 {
-		userCSSProvider := gtk.NewCssProvider()
-		p.AddRef(userCSSProvider.Unref)
-		userCSSProvider.LoadFromData(string(p.stylesheet), len(p.stylesheet))
-		gtk.StyleContextAddProviderForDisplay(display, userCSSProvider, uint(gtk.STYLE_PROVIDER_PRIORITY_USER))
-	}
+	// The user provider is always installed so that stylesheet updates can be
+	// loaded into it in place.
+	p.userCSSProvider = gtk.NewCssProvider()
+	p.AddRef(p.userCSSProvider.Unref)
+	p.userCSSProvider.LoadFromData(string(p.stylesheet), len(p.stylesheet))
+	gtk.StyleContextAddProviderForDisplay(display, p.userCSSProvider, uint(gtk.STYLE_PROVIDER_PRIORITY_USER))
 
 	p.win = gtk.NewWindow()
 	p.AddRef(p.win.Unref)
@@ -129,6 +169,9 @@ func (p *panel) initWindow() error {
 	if err != nil {
 		return err
 	}
+	if len(hyprMonitors) == 0 {
+		return errors.New(`no monitors available`)
+	}
 	if p.panelCfg.Monitor != `` {
 		for _, mon := range hyprMonitors {
 			mon := mon
@@ -139,6 +182,9 @@ func (p *panel) initWindow() error {
 		}
 	}
 	if p.currentMonitor == nil {
+		if p.panelCfg.Monitor != `` {
+			log.Warn(`Configured monitor not found, using first monitor`, `panelID`, p.panelID, `monitor`, p.panelCfg.Monitor)
+		}
 		p.currentMonitor = &hyprMonitors[0]
 	}
 	p.currentGDKMonitor, err = gdkMonitorFromHypr(p.currentMonitor)
@@ -169,7 +215,7 @@ func (p *panel) initWindow() error {
 		gtk4layershell.SetAnchor(p.win, gtk4layershell.LayerShellEdgeTop, true)
 		gtk4layershell.SetAnchor(p.win, gtk4layershell.LayerShellEdgeBottom, true)
 	default:
-		return fmt.Errorf(`panel %s missing position configuration`, p.id)
+		return fmt.Errorf(`panel %s missing position configuration`, p.panelID)
 	}
 	gtk4layershell.SetLayer(p.win, gtk4layershell.LayerShellLayerTop)
 
@@ -215,8 +261,15 @@ func (p *panel) build() error {
 	p.AddRef(p.container.Unref)
 	panelMain.Append(&p.container.Widget)
 
+	hostCaps := p.host.Capabilities()
 	for _, modCfg := range p.panelCfg.Modules {
 		modCfg := modCfg
+		// Modules that require events the host cannot emit would never update.
+		if kinds, ok := moduleEventKinds(modCfg); ok && !panelplugin.SupportsEvents(hostCaps, kinds...) {
+			log.Warn(`Module not supported by hyprpanel, it will not be displayed`, `module`, panelplugin.ModuleName(modCfg), `hostVersion`, hostCaps.Version, `version`, panelplugin.Version())
+			continue
+		}
+		n := len(p.modules)
 		switch modCfg.Kind.(type) {
 		case *modulev1.Module_MediaPlayer:
 			cfg := modCfg.GetMediaPlayer()
@@ -266,21 +319,50 @@ func (p *panel) build() error {
 			cfg := modCfg.GetSpacer()
 			mod := newSpacer(cfg, p.api)
 			p.modules = append(p.modules, mod)
+		case *modulev1.Module_Custom:
+			cfg := modCfg.GetCustom()
+			mod := newCustom(cfg, p.api)
+			p.modules = append(p.modules, mod)
+		case *modulev1.Module_Widget:
+			cfg := modCfg.GetWidget()
+			mod := newWidget(cfg, p.api)
+			p.modules = append(p.modules, mod)
 		default:
-			log.Warn(`Unhandled module config`, `module`, modCfg)
+			log.Warn(`Unhandled module config, hyprpanel-client may be older than hyprpanel`, `module`, modCfg, `version`, panelplugin.Version())
+		}
+		if modCfg.Visibility != nil && len(p.modules) > n {
+			name := panelplugin.ModuleName(modCfg)
+			mod, err := newConditionalModule(modCfg.Visibility, p.modules[n], name, p.api)
+			if err != nil {
+				log.Warn(`Invalid module visibility, module will always be displayed`, `module`, name, `err`, err)
+				continue
+			}
+			p.modules[n] = mod
 		}
 	}
 
 	for _, mod := range p.modules {
 		mod := mod
 		if rec, ok := mod.(moduleReceiver); ok {
-			p.receivers[mod] = rec.events()
+			p.receivers[mod] = p.receive(mod, rec.events())
+		}
+		// Conditional modules receive events for their conditions, the wrapped
+		// module receives its own.
+		var wrapped module
+		if cond, ok := mod.(*conditionalModule); ok {
+			if rec, ok := cond.mod.(moduleReceiver); ok {
+				wrapped = cond.mod
+				p.receivers[wrapped] = p.receive(wrapped, rec.events())
+			}
 		}
 		if err := mod.build(p.container); err != nil {
 			return err
 		}
 		p.AddRef(func() {
 			delete(p.receivers, mod)
+			if wrapped != nil {
+				delete(p.receivers, wrapped)
+			}
 			mod.close(p.container)
 		})
 	}
@@ -293,11 +375,25 @@ func (p *panel) build() error {
 	return nil
 }
 
+// receive starts delivery from a bounded queue to the module's event channel,
+// so that a stalled module does not block event delivery to other modules.
+func (p *panel) receive(mod module, ch chan<- *eventv1.Event) *eventqueue.Queue {
+	queue := eventqueue.New(moduleQueueSize)
+	go queue.Run(p.quitCh, log.With(`panelID`, p.panelID, `module`, fmt.Sprintf("%T", mod)), func(evt *eventv1.Event) {
+		select {
+		case <-p.quitCh:
+		case ch <- evt:
+		}
+	})
+
+	return queue
+}
+
 func (p *panel) watch() {
 	for evt := range p.eventCh {
-		log.Trace(`received panel event`, `panelID`, p.id, `evt`, evt.Kind.String())
-		for _, rec := range p.receivers {
-			rec <- evt
+		log.Trace(`received panel event`, `panelID`, p.panelID, `evt`, evt.Kind.String())
+		for _, queue := range p.receivers {
+			queue.Push(evt)
 		}
 	}
 }
@@ -310,20 +406,14 @@ func (p *panel) run() int {
 }
 
 func newPanel() (*panel, error) {
-	hypr, err := hypripc.New(log)
-	if err != nil {
-		return nil, err
-	}
-
 	p := &panel{
 		refTracker: newRefTracker(),
 		api: &api{
-			hypr: hypr,
-			app:  gtk.NewApplication(appName, gio.GApplicationFlagsNoneValue),
+			app: gtk.NewApplication(appName, gio.GApplicationFlagsNoneValue),
 		},
 		modules:   make([]module, 0),
 		eventCh:   make(chan *eventv1.Event, 10),
-		receivers: make(map[module]chan<- *eventv1.Event),
+		receivers: make(map[module]*eventqueue.Queue),
 		readyCh:   make(chan struct{}),
 		quitCh:    make(chan struct{}),
 	}
@@ -0,0 +1,255 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// condition tracks the result of a visibility condition from received events.
+type condition struct {
+	kind    eventv1.EventKind
+	where   map[string]*regexp.Regexp
+	field   string
+	matches *regexp.Regexp
+	negate  bool
+	result  bool
+}
+
+// update evaluates the event data msg of kind, if the condition applies to it.
+func (c *condition) update(kind eventv1.EventKind, msg protoreflect.Message) {
+	if kind != c.kind {
+		return
+	}
+	for path, re := range c.where {
+		v, ok := fieldValue(msg, path)
+		if !ok || !re.MatchString(v) {
+			return
+		}
+	}
+	v, ok := fieldValue(msg, c.field)
+	c.result = ok && c.matches.MatchString(v)
+	if c.negate {
+		c.result = !c.result
+	}
+}
+
+func newCondition(cfg *modulev1.Condition) (*condition, error) {
+	kind, ok := eventv1.ParseEventKind(cfg.Event)
+	if !ok {
+		return nil, fmt.Errorf("unknown event %q", cfg.Event)
+	}
+	matches, err := regexp.Compile(cfg.Matches)
+	if err != nil {
+		return nil, fmt.Errorf("invalid matches expression: %w", err)
+	}
+	c := &condition{
+		kind:    kind,
+		where:   make(map[string]*regexp.Regexp, len(cfg.Where)),
+		field:   cfg.Field,
+		matches: matches,
+		negate:  cfg.Negate,
+		result:  cfg.Initial,
+	}
+	for path, expr := range cfg.Where {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid where expression for %s: %w", path, err)
+		}
+		c.where[path] = re
+	}
+
+	return c, nil
+}
+
+// fieldValue returns the string form of the field at the dot-separated path in
+// msg. An empty path selects the value of single field messages, such as
+// wrappers.
+func fieldValue(msg protoreflect.Message, path string) (string, bool) {
+	var field protoreflect.FieldDescriptor
+	if path == `` {
+		fields := msg.Descriptor().Fields()
+		if fields.Len() != 1 {
+			return ``, false
+		}
+		field = fields.Get(0)
+	} else {
+		names := strings.Split(path, `.`)
+		for i, name := range names {
+			fields := msg.Descriptor().Fields()
+			field = fields.ByName(protoreflect.Name(name))
+			if field == nil {
+				field = fields.ByJSONName(name)
+			}
+			if field == nil {
+				return ``, false
+			}
+			if i < len(names)-1 {
+				if field.Message() == nil || field.IsList() || field.IsMap() {
+					return ``, false
+				}
+				msg = msg.Get(field).Message()
+			}
+		}
+	}
+	if field.IsList() || field.IsMap() {
+		return ``, false
+	}
+
+	v := msg.Get(field)
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		if value := field.Enum().Values().ByNumber(v.Enum()); value != nil {
+			return string(value.Name()), true
+		}
+		return strconv.Itoa(int(v.Enum())), true
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		b, err := protojson.Marshal(v.Message().Interface())
+		if err != nil {
+			return ``, false
+		}
+		return strings.Trim(string(b), `"`), true
+	case protoreflect.BytesKind:
+		return ``, false
+	default:
+		return v.String(), true
+	}
+}
+
+// visibilityEventKinds returns the event kinds evaluated by cfg.
+func visibilityEventKinds(cfg *modulev1.Visibility) []eventv1.EventKind {
+	kinds := make([]eventv1.EventKind, 0, len(cfg.GetConditions()))
+	for _, condCfg := range cfg.GetConditions() {
+		if kind, ok := eventv1.ParseEventKind(condCfg.Event); ok {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	return kinds
+}
+
+// conditionalModule wraps a module in a container that is only displayed
+// while the configured visibility conditions hold.
+type conditionalModule struct {
+	*refTracker
+	*api
+	cfg        *modulev1.Visibility
+	mod        module
+	name       string
+	conditions []*condition
+	eventCh    chan *eventv1.Event
+	quitCh     chan struct{}
+
+	visible   bool
+	container *gtk.Box
+}
+
+func (c *conditionalModule) build(container *gtk.Box) error {
+	c.container = gtk.NewBox(c.orientation, 0)
+	c.AddRef(c.container.Unref)
+	c.visible = c.evaluate()
+	c.container.SetVisible(c.visible)
+	container.Append(&c.container.Widget)
+
+	if err := c.mod.build(c.container); err != nil {
+		return err
+	}
+
+	go c.watch()
+
+	return nil
+}
+
+func (c *conditionalModule) events() chan<- *eventv1.Event {
+	return c.eventCh
+}
+
+func (c *conditionalModule) close(container *gtk.Box) {
+	defer c.Unref()
+	c.mod.close(c.container)
+	container.Remove(&c.container.Widget)
+}
+
+// evaluate returns true if the module should be displayed.
+func (c *conditionalModule) evaluate() bool {
+	if len(c.conditions) == 0 {
+		return true
+	}
+	for _, cond := range c.conditions {
+		if cond.result == c.cfg.Any {
+			return c.cfg.Any
+		}
+	}
+
+	return !c.cfg.Any
+}
+
+func (c *conditionalModule) watch() {
+	for {
+		select {
+		case <-c.quitCh:
+			return
+		default:
+		}
+		select {
+		case <-c.quitCh:
+			return
+		case evt := <-c.eventCh:
+			msg, err := evt.Data.UnmarshalNew()
+			if err != nil {
+				log.Warn(`Invalid event`, `module`, c.name, `err`, err, `evt`, evt)
+				continue
+			}
+			for _, cond := range c.conditions {
+				cond.update(evt.Kind, msg.ProtoReflect())
+			}
+			visible := c.evaluate()
+			if visible == c.visible {
+				continue
+			}
+			c.visible = visible
+			log.Debug(`Module visibility changed`, `module`, c.name, `visible`, visible)
+
+			var cb glib.SourceFunc
+			cb = func(uintptr) bool {
+				defer unrefCallback(&cb)
+				c.container.SetVisible(visible)
+				return false
+			}
+			glib.IdleAdd(&cb, 0)
+		}
+	}
+}
+
+func newConditionalModule(cfg *modulev1.Visibility, mod module, name string, a *api) (*conditionalModule, error) {
+	c := &conditionalModule{
+		refTracker: newRefTracker(),
+		api:        a,
+		cfg:        cfg,
+		mod:        mod,
+		name:       name,
+		conditions: make([]*condition, 0, len(cfg.Conditions)),
+		eventCh:    make(chan *eventv1.Event),
+		quitCh:     make(chan struct{}),
+	}
+	for i, condCfg := range cfg.Conditions {
+		cond, err := newCondition(condCfg)
+		if err != nil {
+			return nil, fmt.Errorf("condition %d: %w", i, err)
+		}
+		c.conditions = append(c.conditions, cond)
+	}
+	c.AddRef(func() {
+		close(c.quitCh)
+	})
+
+	return c, nil
+}
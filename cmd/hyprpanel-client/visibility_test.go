@@ -0,0 +1,156 @@
+package main
+
+import (
+	"testing"
+
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newTestEvent(t *testing.T, kind eventv1.EventKind, data proto.Message) *eventv1.Event {
+	t.Helper()
+	v, err := anypb.New(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &eventv1.Event{Kind: kind, Data: v}
+}
+
+func newTestStringEvent(t *testing.T, kind eventv1.EventKind, value string) *eventv1.Event {
+	t.Helper()
+	evt, err := eventv1.NewString(kind, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return evt
+}
+
+func TestConditionUpdate(t *testing.T) {
+	type step struct {
+		evt  *eventv1.Event
+		want bool
+	}
+
+	title := `Title`
+	identity := `Player`
+	playing := newTestEvent(t, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, &eventv1.MediaPlayerValueChange{
+		State:    eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_PLAYING,
+		TrackId:  `/track/1`,
+		Title:    &title,
+		Identity: &identity,
+	})
+	paused := newTestEvent(t, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, &eventv1.MediaPlayerValueChange{
+		State:    eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_PAUSED,
+		TrackId:  `/track/1`,
+		Title:    &title,
+		Identity: &identity,
+	})
+	noPlayer := newTestEvent(t, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, &eventv1.MediaPlayerValueChange{
+		State: eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_STOPPED,
+	})
+	linePower := func(online bool) *eventv1.Event {
+		return newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE, &eventv1.PowerChangeValue{
+			Id:     `line_power_AC`,
+			Type:   eventv1.PowerType_POWER_TYPE_LINE_POWER,
+			Online: online,
+		})
+	}
+	battery := newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE, &eventv1.PowerChangeValue{
+		Id:         `battery_BAT0`,
+		Type:       eventv1.PowerType_POWER_TYPE_BATTERY,
+		Percentage: 50,
+	})
+
+	tests := []struct {
+		name  string
+		cfg   *modulev1.Condition
+		steps []step
+	}{
+		{
+			name: `media player active`,
+			cfg:  &modulev1.Condition{Event: `MEDIA_PLAYER_CHANGE`, Field: `state`, Matches: `PLAYING|PAUSED`},
+			steps: []step{
+				{evt: playing, want: true},
+				{evt: paused, want: true},
+				{evt: noPlayer, want: false},
+				{evt: playing, want: true},
+			},
+		},
+		{
+			name: `media player identity`,
+			cfg:  &modulev1.Condition{Event: `EVENT_KIND_MEDIA_PLAYER_CHANGE`, Field: `identity`, Matches: `^Player$`},
+			steps: []step{
+				{evt: playing, want: true},
+				{evt: noPlayer, want: false},
+			},
+		},
+		{
+			name: `on battery`,
+			cfg: &modulev1.Condition{
+				Event:   `DBUS_POWER_CHANGE`,
+				Where:   map[string]string{`type`: `LINE_POWER`},
+				Field:   `online`,
+				Matches: `^false$`,
+			},
+			steps: []step{
+				{evt: linePower(false), want: true},
+				{evt: battery, want: true},
+				{evt: linePower(true), want: false},
+				{evt: playing, want: false},
+			},
+		},
+		{
+			name: `not fullscreen`,
+			cfg:  &modulev1.Condition{Event: `HYPR_FULLSCREEN`, Matches: `^1$`, Negate: true, Initial: true},
+			steps: []step{
+				{evt: newTestStringEvent(t, eventv1.EventKind_EVENT_KIND_HYPR_FULLSCREEN, `1`), want: false},
+				{evt: newTestStringEvent(t, eventv1.EventKind_EVENT_KIND_HYPR_FULLSCREEN, `0`), want: true},
+			},
+		},
+		{
+			name: `screencast`,
+			cfg:  &modulev1.Condition{Event: `HYPR_SCREENCAST`, Matches: `^1`},
+			steps: []step{
+				{evt: newTestStringEvent(t, eventv1.EventKind_EVENT_KIND_HYPR_SCREENCAST, `1,0`), want: true},
+				{evt: newTestStringEvent(t, eventv1.EventKind_EVENT_KIND_HYPR_SCREENCAST, `0,0`), want: false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond, err := newCondition(tt.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cond.result != tt.cfg.Initial {
+				t.Fatalf("got initial result %v, want %v", cond.result, tt.cfg.Initial)
+			}
+			for i, s := range tt.steps {
+				msg, err := s.evt.Data.UnmarshalNew()
+				if err != nil {
+					t.Fatal(err)
+				}
+				cond.update(s.evt.Kind, msg.ProtoReflect())
+				if cond.result != s.want {
+					t.Fatalf("step %d: got result %v, want %v", i, cond.result, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewConditionInvalid(t *testing.T) {
+	tests := []*modulev1.Condition{
+		{Event: `NOT_AN_EVENT`},
+		{Event: `MEDIA_PLAYER_CHANGE`, Matches: `(`},
+		{Event: `MEDIA_PLAYER_CHANGE`, Where: map[string]string{`state`: `[`}},
+	}
+	for _, cfg := range tests {
+		if _, err := newCondition(cfg); err == nil {
+			t.Errorf("expected error for %+v", cfg)
+		}
+	}
+}
@@ -0,0 +1,469 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/jwijenbergh/puregotk/v4/gdk"
+	"github.com/jwijenbergh/puregotk/v4/gio"
+	"github.com/jwijenbergh/puregotk/v4/glib"
+	"github.com/jwijenbergh/puregotk/v4/gtk"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	widgetv1 "github.com/pdf/hyprpanel/proto/hyprpanel/widget/v1"
+	"github.com/pdf/hyprpanel/style"
+	"github.com/pdf/hyprpanel/widget"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	widgetActionNamespace = `widget`
+	widgetMenuID          = `widgetMenu`
+	widgetRetryDelay      = 5 * time.Second
+	widgetCallTimeout     = 5 * time.Second
+)
+
+type widgetModule struct {
+	*refTracker
+	*api
+	cfg    *modulev1.Widget
+	ctx    context.Context
+	cancel context.CancelFunc
+	quitCh chan struct{}
+
+	mu     sync.RWMutex
+	client hyprpanelv1.WidgetServiceClient
+
+	container   *gtk.Box
+	root        *gtk.Widget
+	treeRefs    *refTracker
+	actionGroup *gio.SimpleActionGroup
+	actionID    int
+}
+
+func (w *widgetModule) build(container *gtk.Box) error {
+	if (w.cfg.Path == ``) == (w.cfg.Socket == ``) {
+		return errors.New(`widget module requires exactly one of path or socket`)
+	}
+
+	w.container = gtk.NewBox(w.orientation, 0)
+	w.container.SetName(style.WidgetID)
+	w.container.AddCssClass(style.ModuleClass)
+	if w.cfg.Name != `` {
+		w.container.AddCssClass(w.cfg.Name)
+	}
+
+	container.Append(&w.container.Widget)
+
+	go w.watch()
+
+	return nil
+}
+
+// watch maintains the connection to the widget, reconnecting on failure.
+func (w *widgetModule) watch() {
+	for {
+		if err := w.run(); err != nil && w.ctx.Err() == nil {
+			log.Warn(`Widget connection failed`, `module`, style.WidgetID, `name`, w.cfg.Name, `err`, err)
+		}
+
+		select {
+		case <-w.quitCh:
+			return
+		case <-time.After(widgetRetryDelay):
+		}
+	}
+}
+
+func (w *widgetModule) run() error {
+	client, closer, err := w.dial()
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	if _, err := client.Init(w.ctx, &hyprpanelv1.WidgetServiceInitRequest{
+		PanelId: w.panelID,
+		Name:    w.cfg.Name,
+		Config:  w.cfg.Config,
+	}); err != nil {
+		return fmt.Errorf("failed initializing widget: %w", err)
+	}
+
+	stream, err := client.Watch(w.ctx, &hyprpanelv1.WidgetServiceWatchRequest{PanelId: w.panelID})
+	if err != nil {
+		return fmt.Errorf("failed watching widget: %w", err)
+	}
+
+	w.mu.Lock()
+	w.client = client
+	w.mu.Unlock()
+	defer func() {
+		w.mu.Lock()
+		w.client = nil
+		w.mu.Unlock()
+	}()
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		w.publish(resp.Root)
+	}
+}
+
+// dial connects to the widget, launching the plugin executable if required,
+// and returns a function that releases the connection.
+func (w *widgetModule) dial() (hyprpanelv1.WidgetServiceClient, func(), error) {
+	if w.cfg.Socket != `` {
+		conn, err := grpc.NewClient(`unix://`+w.cfg.Socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, nil, err
+		}
+		return hyprpanelv1.NewWidgetServiceClient(conn), func() { _ = conn.Close() }, nil
+	}
+
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  widget.Handshake,
+		Plugins:          widget.PluginMap,
+		Cmd:              exec.Command(w.cfg.Path, w.cfg.Args...),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Logger:           log.Named(style.WidgetID).With(`name`, w.cfg.Name),
+	})
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed launching widget: %w", err)
+	}
+	raw, err := rpcClient.Dispense(widget.PluginName)
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed dispensing widget: %w", err)
+	}
+
+	return raw.(hyprpanelv1.WidgetServiceClient), client.Kill, nil
+}
+
+// call invokes fn against the connected widget, off the GTK thread.
+func (w *widgetModule) call(action string, fn func(ctx context.Context, client hyprpanelv1.WidgetServiceClient) error) {
+	w.mu.RLock()
+	client := w.client
+	w.mu.RUnlock()
+	if client == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(w.ctx, widgetCallTimeout)
+		defer cancel()
+		if err := fn(ctx, client); err != nil {
+			log.Warn(`Widget callback failed`, `module`, style.WidgetID, `name`, w.cfg.Name, `action`, action, `err`, err)
+		}
+	}()
+}
+
+func (w *widgetModule) click(nodeID string, button widgetv1.Button) {
+	w.call(`click`, func(ctx context.Context, client hyprpanelv1.WidgetServiceClient) error {
+		_, err := client.Click(ctx, &hyprpanelv1.WidgetServiceClickRequest{PanelId: w.panelID, NodeId: nodeID, Button: button})
+		return err
+	})
+}
+
+func (w *widgetModule) scroll(nodeID string, direction eventv1.Direction) {
+	w.call(`scroll`, func(ctx context.Context, client hyprpanelv1.WidgetServiceClient) error {
+		_, err := client.Scroll(ctx, &hyprpanelv1.WidgetServiceScrollRequest{PanelId: w.panelID, NodeId: nodeID, Direction: direction})
+		return err
+	})
+}
+
+func (w *widgetModule) menuActivate(nodeID, itemID string) {
+	w.call(`menu`, func(ctx context.Context, client hyprpanelv1.WidgetServiceClient) error {
+		_, err := client.MenuActivate(ctx, &hyprpanelv1.WidgetServiceMenuActivateRequest{PanelId: w.panelID, NodeId: nodeID, ItemId: itemID})
+		return err
+	})
+}
+
+func (w *widgetModule) publish(root *widgetv1.Node) {
+	var cb glib.SourceFunc
+	cb = func(uintptr) bool {
+		defer unrefCallback(&cb)
+		select {
+		case <-w.quitCh:
+			return false
+		default:
+		}
+		w.render(root)
+		return false
+	}
+
+	glib.IdleAdd(&cb, 0)
+}
+
+// render replaces the displayed tree with root.
+func (w *widgetModule) render(root *widgetv1.Node) {
+	if w.root != nil {
+		w.container.Remove(w.root)
+		w.root = nil
+	}
+	if w.treeRefs != nil {
+		w.treeRefs.Unref()
+	}
+	w.treeRefs = newRefTracker()
+	if w.actionGroup != nil {
+		actionGroup := w.actionGroup
+		defer actionGroup.Unref()
+	}
+	w.actionGroup = gio.NewSimpleActionGroup()
+	w.container.InsertActionGroup(widgetActionNamespace, w.actionGroup)
+
+	if root == nil {
+		return
+	}
+	w.root = w.buildNode(root)
+	if w.root != nil {
+		w.container.Append(w.root)
+	}
+}
+
+// buildNode creates the widget for node and its children. Nodes that fail to
+// build are omitted.
+func (w *widgetModule) buildNode(node *widgetv1.Node) *gtk.Widget {
+	var nodeWidget *gtk.Widget
+	switch kind := node.Kind.(type) {
+	case *widgetv1.Node_Box:
+		orientation := w.orientation
+		switch kind.Box.Orientation {
+		case widgetv1.Orientation_ORIENTATION_HORIZONTAL:
+			orientation = gtk.OrientationHorizontalValue
+		case widgetv1.Orientation_ORIENTATION_VERTICAL:
+			orientation = gtk.OrientationVerticalValue
+		}
+		box := gtk.NewBox(orientation, int(kind.Box.Spacing))
+		for _, child := range kind.Box.Children {
+			if childWidget := w.buildNode(child); childWidget != nil {
+				box.Append(childWidget)
+			}
+		}
+		nodeWidget = &box.Widget
+	case *widgetv1.Node_Label:
+		label := gtk.NewLabel(``)
+		if kind.Label.Markup {
+			label.SetMarkup(kind.Label.Text)
+		} else {
+			label.SetText(kind.Label.Text)
+		}
+		nodeWidget = &label.Widget
+	case *widgetv1.Node_Icon:
+		icon, err := createIcon(kind.Icon.Name, int(kind.Icon.Size), kind.Icon.Symbolic, nil)
+		if err != nil {
+			log.Warn(`Failed creating icon`, `module`, style.WidgetID, `name`, w.cfg.Name, `node`, node.Id, `err`, err)
+			return nil
+		}
+		nodeWidget = &icon.Widget
+	case *widgetv1.Node_Progress:
+		progress := gtk.NewProgressBar()
+		progress.SetFraction(min(max(kind.Progress.Fraction, 0), 1))
+		progress.SetValign(gtk.AlignCenterValue)
+		nodeWidget = &progress.Widget
+	default:
+		nodeWidget = &gtk.NewBox(w.orientation, 0).Widget
+	}
+
+	nodeWidget.AddCssClass(style.WidgetNodeClass)
+	for _, class := range node.CssClasses {
+		nodeWidget.AddCssClass(class)
+	}
+	if node.Tooltip != `` {
+		nodeWidget.SetTooltipMarkup(node.Tooltip)
+	}
+
+	var menu *gtk.PopoverMenu
+	if len(node.Menu) > 0 {
+		var err error
+		if menu, err = w.buildMenu(node); err != nil {
+			log.Warn(`Failed building menu`, `module`, style.WidgetID, `name`, w.cfg.Name, `node`, node.Id, `err`, err)
+		} else {
+			// Popovers require a parent, so wrap the node to hold the menu.
+			wrapper := gtk.NewBox(w.orientation, 0)
+			wrapper.Append(nodeWidget)
+			wrapper.Append(&menu.Widget)
+			nodeWidget = &wrapper.Widget
+		}
+	}
+
+	if node.Id == `` && menu == nil {
+		return nodeWidget
+	}
+
+	clickController := gtk.NewGestureClick()
+	clickController.SetButton(0)
+	pressedCb := func(ctrl gtk.GestureClick, nPress int, x, y float64) {
+		// Claim the sequence, so that only the innermost node receives input.
+		ctrl.SetState(gtk.EventSequenceClaimedValue)
+	}
+	clickController.ConnectPressed(&pressedCb)
+	releasedCb := func(ctrl gtk.GestureClick, nPress int, x, y float64) {
+		switch ctrl.GetCurrentButton() {
+		case uint(gdk.BUTTON_PRIMARY):
+			w.click(node.Id, widgetv1.Button_BUTTON_PRIMARY)
+		case uint(gdk.BUTTON_MIDDLE):
+			w.click(node.Id, widgetv1.Button_BUTTON_MIDDLE)
+		case uint(gdk.BUTTON_SECONDARY):
+			if menu != nil {
+				menu.Popup()
+				return
+			}
+			w.click(node.Id, widgetv1.Button_BUTTON_SECONDARY)
+		}
+	}
+	clickController.ConnectReleased(&releasedCb)
+	nodeWidget.AddController(&clickController.EventController)
+	w.treeRefs.AddRef(func() {
+		unrefCallback(&pressedCb)
+		unrefCallback(&releasedCb)
+	})
+
+	if node.Id == `` {
+		return nodeWidget
+	}
+
+	scrollCb := func(_ gtk.EventControllerScroll, dx, dy float64) bool {
+		if dy < 0 {
+			w.scroll(node.Id, eventv1.Direction_DIRECTION_UP)
+		} else {
+			w.scroll(node.Id, eventv1.Direction_DIRECTION_DOWN)
+		}
+
+		return true
+	}
+	w.treeRefs.AddRef(func() {
+		unrefCallback(&scrollCb)
+	})
+
+	scrollController := gtk.NewEventControllerScroll(gtk.EventControllerScrollVerticalValue | gtk.EventControllerScrollDiscreteValue)
+	scrollController.ConnectScroll(&scrollCb)
+	nodeWidget.AddController(&scrollController.EventController)
+
+	return nodeWidget
+}
+
+func (w *widgetModule) buildMenu(node *widgetv1.Node) (*gtk.PopoverMenu, error) {
+	section := &menuXMLMenuSection{}
+	x := menuXMLInterface{
+		Menu: &menuXMLMenu{
+			ID:       widgetMenuID,
+			Sections: []*menuXMLMenuSection{section},
+		},
+	}
+	w.buildMenuXMLSection(node.Id, node.Menu, section)
+
+	b, err := xml.Marshal(x)
+	if err != nil {
+		return nil, err
+	}
+	menuXML := append([]byte(xml.Header), b...)
+
+	builder := gtk.NewBuilderFromString(string(menuXML), len(menuXML))
+	defer builder.Unref()
+
+	menuObj := builder.GetObject(widgetMenuID)
+	if menuObj == nil {
+		return nil, errors.New(`could not build menu`)
+	}
+	defer menuObj.Unref()
+
+	menuModel := &gio.MenuModel{}
+	menuObj.Cast(menuModel)
+	menu := gtk.NewPopoverMenuFromModel(menuModel)
+
+	switch w.panelCfg.Edge {
+	case configv1.Edge_EDGE_TOP:
+		menu.SetPosition(gtk.PosBottomValue)
+	case configv1.Edge_EDGE_RIGHT:
+		menu.SetPosition(gtk.PosLeftValue)
+	case configv1.Edge_EDGE_BOTTOM:
+		menu.SetPosition(gtk.PosTopValue)
+	case configv1.Edge_EDGE_LEFT:
+		menu.SetPosition(gtk.PosRightValue)
+	}
+
+	return menu, nil
+}
+
+func (w *widgetModule) buildMenuXMLSection(nodeID string, items []*widgetv1.MenuItem, section *menuXMLMenuSection) {
+	for _, item := range items {
+		attributes := []*menuXMLAttribute{{Name: `label`, Value: item.Label}}
+
+		if len(item.Children) > 0 {
+			subSection := &menuXMLMenuSection{}
+			section.Submenus = append(section.Submenus, &menuXMLMenuSubmenu{
+				Sections:   []*menuXMLMenuSection{subSection},
+				Attributes: attributes,
+			})
+			w.buildMenuXMLSection(nodeID, item.Children, subSection)
+			continue
+		}
+
+		w.actionID++
+		actionName := fmt.Sprintf("item-%d", w.actionID)
+		action := gio.NewSimpleAction(actionName, nil)
+		itemID := item.Id
+		cb := func(action gio.SimpleAction, param uintptr) {
+			w.menuActivate(nodeID, itemID)
+		}
+		w.treeRefs.AddRef(func() {
+			unrefCallback(&cb)
+		})
+		action.SetEnabled(!item.Disabled)
+		action.ConnectActivate(&cb)
+		w.actionGroup.AddAction(action)
+
+		section.Items = append(section.Items, &menuXMLItem{
+			Attributes: append(attributes, &menuXMLAttribute{
+				Name:  `action`,
+				Value: widgetActionNamespace + `.` + actionName,
+			}),
+		})
+	}
+}
+
+func (w *widgetModule) close(container *gtk.Box) {
+	defer w.Unref()
+	log.Debug(`Closing module on request`, `module`, style.WidgetID, `name`, w.cfg.Name)
+	container.Remove(&w.container.Widget)
+	if w.treeRefs != nil {
+		w.treeRefs.Unref()
+	}
+	if w.actionGroup != nil {
+		w.actionGroup.Unref()
+	}
+}
+
+func newWidget(cfg *modulev1.Widget, a *api) *widgetModule {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &widgetModule{
+		refTracker: newRefTracker(),
+		api:        a,
+		cfg:        cfg,
+		ctx:        ctx,
+		cancel:     cancel,
+		quitCh:     make(chan struct{}),
+	}
+
+	w.AddRef(func() {
+		close(w.quitCh)
+		w.cancel()
+	})
+
+	return w
+}
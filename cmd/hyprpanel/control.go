@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/panelplugin"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"google.golang.org/grpc"
+)
+
+const (
+	controlSocketName  = `control.sock`
+	controlDialTimeout = 500 * time.Millisecond
+)
+
+// control serves the HostService and ControlService APIs on a unix socket, for
+// use by `hyprpanel ctl`.
+type control struct {
+	hyprpanelv1.UnimplementedControlServiceServer
+
+	log      hclog.Logger
+	path     string
+	listener net.Listener
+	server   *grpc.Server
+	reload   func() error
+	quit     func()
+	events   *eventTap
+}
+
+// Reload implementation.
+func (c *control) Reload(_ context.Context, _ *hyprpanelv1.ControlServiceReloadRequest) (*hyprpanelv1.ControlServiceReloadResponse, error) {
+	c.log.Info(`Reload requested via control socket`)
+	if err := c.reload(); err != nil {
+		return &hyprpanelv1.ControlServiceReloadResponse{}, err
+	}
+
+	return &hyprpanelv1.ControlServiceReloadResponse{}, nil
+}
+
+// Quit implementation.
+func (c *control) Quit(_ context.Context, _ *hyprpanelv1.ControlServiceQuitRequest) (*hyprpanelv1.ControlServiceQuitResponse, error) {
+	c.log.Warn(`Quit requested via control socket`)
+	go c.quit()
+
+	return &hyprpanelv1.ControlServiceQuitResponse{}, nil
+}
+
+// Events implementation.
+func (c *control) Events(_ *hyprpanelv1.ControlServiceEventsRequest, stream hyprpanelv1.ControlService_EventsServer) error {
+	sub, cancel := c.events.subscribe()
+	defer cancel()
+
+	c.log.Info(`Event stream opened via control socket`)
+	defer func() {
+		c.log.Info(`Event stream closed`, `dropped`, sub.dropped.Load())
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case rec := <-sub.ch:
+			if err := stream.Send(&hyprpanelv1.ControlServiceEventsResponse{Event: rec}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (c *control) serve() {
+	if err := c.server.Serve(c.listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		c.log.Error(`Control socket failed`, `err`, err)
+	}
+}
+
+func (c *control) Close() {
+	c.server.Stop()
+	if err := os.Remove(c.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		c.log.Warn(`Failed removing control socket`, `path`, c.path, `err`, err)
+	}
+}
+
+func newControl(h panelplugin.Host, events *eventTap, reload func() error, quit func(), log hclog.Logger) (*control, error) {
+	path, err := controlSocketPath()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+
+	if conn, err := net.DialTimeout(`unix`, path, controlDialTimeout); err == nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("control socket %s is in use by another instance", path)
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	listener, err := net.Listen(`unix`, path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	c := &control{
+		log:      log,
+		path:     path,
+		listener: listener,
+		server:   grpc.NewServer(),
+		reload:   reload,
+		quit:     quit,
+		events:   events,
+	}
+	hyprpanelv1.RegisterHostServiceServer(c.server, &panelplugin.HostGRPCServer{Impl: h})
+	hyprpanelv1.RegisterControlServiceServer(c.server, c)
+
+	go c.serve()
+
+	return c, nil
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pdf/hyprpanel/internal/panelplugin"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"github.com/peterbourgon/ff/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const ctlTimeout = 5 * time.Second
+
+const ctlHelp = `VERBS
+  volume up|down|mute                 adjust the default audio output
+  mic up|down|mute                    adjust the default audio input
+  brightness up|down [DEVICE]         adjust display brightness, all devices if DEVICE is omitted
+  idle inhibit|uninhibit [TARGET]     toggle idle inhibition, TARGET is one of idle (default), sleep, shutdown
+  media play-pause|play|pause|stop    control the active media player
+  media next|previous                 skip tracks on the active media player
+  media seek OFFSET                   seek the active media player by OFFSET (e.g. 10s, -5s)
+  media position TRACK_ID POSITION    set the position of TRACK_ID (e.g. 1m30s)
+  exec COMMAND [ARG...]               execute a command via the configured launch_wrapper
+  reload                              reload configuration and stylesheet from disk
+  quit                                terminate the running hyprpanel instance`
+
+var errCtlUsage = errors.New(`invalid arguments`)
+
+func newCtlCommand(parent *ff.FlagSet) *ff.Command {
+	fs := ff.NewFlagSet(`ctl`).SetParent(parent)
+
+	return &ff.Command{
+		Name:      `ctl`,
+		Usage:     name + ` ctl VERB [ARG...]`,
+		ShortHelp: `control a running hyprpanel instance`,
+		LongHelp:  ctlHelp,
+		Flags:     fs,
+		Exec:      runCtl,
+	}
+}
+
+func runCtl(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errCtlUsage
+	}
+
+	path, err := controlSocketPath()
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.NewClient(`unix://`+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	h := panelplugin.NewHostGRPCClient(conn)
+	verb, args := args[0], args[1:]
+	switch verb {
+	case `volume`, `mic`:
+		if len(args) != 1 {
+			return errCtlUsage
+		}
+		return ctlAudio(h, verb == `mic`, args[0])
+	case `brightness`:
+		if len(args) < 1 || len(args) > 2 {
+			return errCtlUsage
+		}
+		dir, err := ctlDirection(args[0])
+		if err != nil {
+			return err
+		}
+		devName := ``
+		if len(args) == 2 {
+			devName = args[1]
+		}
+		return h.BrightnessAdjust(devName, dir)
+	case `idle`:
+		return ctlIdle(h, args)
+	case `media`:
+		return ctlMedia(h, args)
+	case `exec`:
+		if len(args) == 0 {
+			return errCtlUsage
+		}
+		return h.Exec(&hyprpanelv1.AppInfo_Action{Exec: args, RawExec: strings.Join(args, ` `)})
+	case `reload`:
+		ctx, cancel := context.WithTimeout(ctx, ctlTimeout)
+		defer cancel()
+		_, err := hyprpanelv1.NewControlServiceClient(conn).Reload(ctx, &hyprpanelv1.ControlServiceReloadRequest{})
+		return err
+	case `quit`:
+		ctx, cancel := context.WithTimeout(ctx, ctlTimeout)
+		defer cancel()
+		_, err := hyprpanelv1.NewControlServiceClient(conn).Quit(ctx, &hyprpanelv1.ControlServiceQuitRequest{})
+		return err
+	default:
+		return fmt.Errorf("%w: unknown verb %q", errCtlUsage, verb)
+	}
+}
+
+func ctlDirection(arg string) (eventv1.Direction, error) {
+	switch arg {
+	case `up`:
+		return eventv1.Direction_DIRECTION_UP, nil
+	case `down`:
+		return eventv1.Direction_DIRECTION_DOWN, nil
+	default:
+		return eventv1.Direction_DIRECTION_UNSPECIFIED, fmt.Errorf("%w: unknown direction %q", errCtlUsage, arg)
+	}
+}
+
+func ctlAudio(h panelplugin.Host, source bool, action string) error {
+	if action == `mute` {
+		if source {
+			return h.AudioSourceMuteToggle(eventv1.AudioDefaultSource)
+		}
+		return h.AudioSinkMuteToggle(eventv1.AudioDefaultSink)
+	}
+
+	dir, err := ctlDirection(action)
+	if err != nil {
+		return err
+	}
+	if source {
+		return h.AudioSourceVolumeAdjust(eventv1.AudioDefaultSource, dir)
+	}
+	return h.AudioSinkVolumeAdjust(eventv1.AudioDefaultSink, dir)
+}
+
+func ctlIdle(h panelplugin.Host, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return errCtlUsage
+	}
+
+	target := eventv1.InhibitTarget_INHIBIT_TARGET_IDLE
+	if len(args) == 2 {
+		switch args[1] {
+		case `idle`:
+		case `sleep`:
+			target = eventv1.InhibitTarget_INHIBIT_TARGET_SLEEP
+		case `shutdown`:
+			target = eventv1.InhibitTarget_INHIBIT_TARGET_SHUTDOWN
+		default:
+			return fmt.Errorf("%w: unknown inhibit target %q", errCtlUsage, args[1])
+		}
+	}
+
+	switch args[0] {
+	case `inhibit`:
+		return h.IdleInhibitorInhibit(target)
+	case `uninhibit`:
+		return h.IdleInhibitorUninhibit(target)
+	default:
+		return fmt.Errorf("%w: unknown idle action %q", errCtlUsage, args[0])
+	}
+}
+
+func ctlMedia(h panelplugin.Host, args []string) error {
+	if len(args) == 0 {
+		return errCtlUsage
+	}
+
+	switch args[0] {
+	case `play-pause`:
+		return h.MediaPlayerPlayPause()
+	case `play`:
+		return h.MediaPlayerPlay()
+	case `pause`:
+		return h.MediaPlayerPause()
+	case `stop`:
+		return h.MediaPlayerStop()
+	case `next`:
+		return h.MediaPlayerNext()
+	case `previous`:
+		return h.MediaPlayerPrevious()
+	case `seek`:
+		if len(args) != 2 {
+			return errCtlUsage
+		}
+		offset, err := time.ParseDuration(args[1])
+		if err != nil {
+			return err
+		}
+		return h.MediaPlayerSeek(offset.Microseconds())
+	case `position`:
+		if len(args) != 3 {
+			return errCtlUsage
+		}
+		pos, err := time.ParseDuration(args[2])
+		if err != nil {
+			return err
+		}
+		return h.MediaPlayerSetPosition(args[1], pos.Microseconds())
+	default:
+		return fmt.Errorf("%w: unknown media action %q", errCtlUsage, args[0])
+	}
+}
@@ -1,11 +1,11 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"os/exec"
-	"path/filepath"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/disintegration/imaging"
@@ -20,37 +20,67 @@ import (
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
 	"github.com/pdf/hyprpanel/wl"
-	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
 	clientName    = `hyprpanel-client`
 	layerShellLib = `libgtk4-layer-shell.so`
 	layerShellPkg = `gtk-layer-shell-0`
+
+	// hyprCacheMaxAge is shorter than the interval that panel modules poll
+	// Hyprland at, so that each poll observes changes that emit no event.
+	hyprCacheMaxAge = 500 * time.Millisecond
 )
 
 var (
-	errReload   = fmt.Errorf(`reloading`)
 	errDisabled = fmt.Errorf(`feature disabled`)
 )
 
 type host struct {
 	cfg         *configv1.Config
-	stylesheet  []byte
+	styles      *stylesheets
 	log         hclog.Logger
 	pluginLog   hclog.Logger
 	wl          *wl.App
 	hypr        *hypripc.HyprIPC
+	hyprCache   *hypripc.Cache
 	hyprEvtCh   <-chan *eventv1.Event
 	dbus        *dbus.Client
 	dbusEvtCh   <-chan *eventv1.Event
 	audio       *audio.Client
 	audioEvtCh  <-chan *eventv1.Event
 	apps        *applications.AppCache
-	panels      []panelplugin.Panel
-	reloadCh    chan struct{}
-	stopWatchCh chan struct{}
+	panels      *supervisor
+	state       *stateStore
+	tap         *eventTap
+	configCh    chan struct{}
+	styleCh     chan struct{}
+	monitorsCh  chan struct{}
+	watchStopCh chan struct{}
+	watchDoneCh chan struct{}
 	quitCh      chan struct{}
+	closeOnce   sync.Once
+
+	pendingMu    sync.Mutex
+	pendingCfg   *configv1.Config
+	pendingStyle *stylesheets
+	styleMu      sync.RWMutex
+
+	// profile is the name of the selected layout profile, empty for the
+	// top-level panels, valid once profileSelected is set.
+	profile         string
+	profileSelected bool
+
+	// theme and colorScheme select the palette applied ahead of styles,
+	// guarded by styleMu.
+	theme       *configv1.Config_Theme
+	colorScheme eventv1.ColorScheme
+
+	// mu guards cfg, apps, dbus and audio against access from host methods
+	// while they are replaced. Only the run loop replaces them, so it may read
+	// them without locking.
+	mu sync.RWMutex
 }
 
 func (h *host) Exec(action *hyprpanelv1.AppInfo_Action) error {
@@ -61,9 +91,10 @@ func (h *host) Exec(action *hyprpanelv1.AppInfo_Action) error {
 		c    string
 		args []string
 	)
-	if len(h.cfg.LaunchWrapper) > 0 {
-		c = h.cfg.LaunchWrapper[0]
-		args = append(h.cfg.LaunchWrapper[1:], action.Exec...)
+	if wrapper := h.config().LaunchWrapper; len(wrapper) > 0 {
+		c = wrapper[0]
+		// Capped, so that append never writes to the shared configuration.
+		args = append(wrapper[1:len(wrapper):len(wrapper)], action.Exec...)
 	} else {
 		c = action.Exec[0]
 		args = action.Exec[1:]
@@ -77,183 +108,270 @@ func (h *host) Exec(action *hyprpanelv1.AppInfo_Action) error {
 }
 
 func (h *host) FindApplication(query string) (*hyprpanelv1.AppInfo, error) {
-	return h.apps.Find(query), nil
+	h.mu.RLock()
+	apps := h.apps
+	h.mu.RUnlock()
+
+	return apps.Find(query), nil
+}
+
+// config returns the running configuration.
+func (h *host) config() *configv1.Config {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.cfg
+}
+
+// dbusClient returns the DBUS client, or errDisabled if it is not connected or
+// the feature selected by enabled is disabled.
+func (h *host) dbusClient(enabled func(*configv1.Config_DBUS) bool) (*dbus.Client, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.dbus == nil || !enabled(h.cfg.Dbus) {
+		return nil, errDisabled
+	}
+	return h.dbus, nil
+}
+
+// audioClient returns the audio client, or errDisabled if it is not connected.
+func (h *host) audioClient() (*audio.Client, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.audio == nil {
+		return nil, errDisabled
+	}
+	return h.audio, nil
+}
+
+func systrayEnabled(cfg *configv1.Config_DBUS) bool {
+	return cfg.GetSystray().GetEnabled()
+}
+
+func notificationsEnabled(cfg *configv1.Config_DBUS) bool {
+	return cfg.GetNotifications().GetEnabled()
+}
+
+func brightnessEnabled(cfg *configv1.Config_DBUS) bool {
+	return cfg.GetBrightness().GetEnabled()
+}
+
+func idleInhibitorEnabled(cfg *configv1.Config_DBUS) bool {
+	return cfg.GetIdleInhibitor().GetEnabled()
+}
+
+func mediaPlayerEnabled(cfg *configv1.Config_DBUS) bool {
+	return cfg.GetMediaPlayer().GetEnabled()
 }
 
 func (h *host) SystrayActivate(busName string, x, y int32) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(systrayEnabled)
+	if err != nil {
+		return err
 	}
-	return h.dbus.Systray().Activate(busName, x, y)
+	return client.Systray().Activate(busName, x, y)
 }
 
 func (h *host) SystraySecondaryActivate(busName string, x, y int32) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(systrayEnabled)
+	if err != nil {
+		return err
 	}
-	return h.dbus.Systray().SecondaryActivate(busName, x, y)
+	return client.Systray().SecondaryActivate(busName, x, y)
 }
 
 func (h *host) SystrayScroll(busName string, delta int32, orientation hyprpanelv1.SystrayScrollOrientation) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(systrayEnabled)
+	if err != nil {
+		return err
 	}
-	return h.dbus.Systray().Scroll(busName, delta, orientation)
+	return client.Systray().Scroll(busName, delta, orientation)
 }
 
 func (h *host) SystrayMenuContextActivate(busName string, x, y int32) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(systrayEnabled)
+	if err != nil {
+		return err
 	}
-	return h.dbus.Systray().MenuContextActivate(busName, x, y)
+	return client.Systray().MenuContextActivate(busName, x, y)
 }
 
 func (h *host) SystrayMenuAboutToShow(busName string, menuItemID string) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(systrayEnabled)
+	if err != nil {
+		return err
 	}
-	return h.dbus.Systray().MenuAboutToShow(busName, menuItemID)
+	return client.Systray().MenuAboutToShow(busName, menuItemID)
 }
 
 func (h *host) SystrayMenuEvent(busName string, id int32, eventID hyprpanelv1.SystrayMenuEvent, data any, timestamp time.Time) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(systrayEnabled)
+	if err != nil {
+		return err
 	}
-	return h.dbus.Systray().MenuEvent(busName, id, eventID, data, timestamp)
+	return client.Systray().MenuEvent(busName, id, eventID, data, timestamp)
 }
 
 func (h *host) NotificationClosed(id uint32, reason hyprpanelv1.NotificationClosedReason) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(notificationsEnabled)
+	if err != nil {
+		return err
 	}
-	return h.dbus.Notification().Closed(id, reason)
+	return client.Notification().Closed(id, reason)
 }
 
 func (h *host) NotificationAction(id uint32, actionKey string) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(notificationsEnabled)
+	if err != nil {
+		return err
 	}
-	return h.dbus.Notification().Action(id, actionKey)
+	return client.Notification().Action(id, actionKey)
 }
 
 func (h *host) AudioSinkVolumeAdjust(id string, direction eventv1.Direction) error {
-	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled {
-		return errDisabled
+	client, err := h.audioClient()
+	if err != nil {
+		return err
 	}
 
-	return h.audio.SinkVolumeAdjust(id, direction)
+	return client.SinkVolumeAdjust(id, direction)
 }
 
 func (h *host) AudioSinkMuteToggle(id string) error {
-	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled {
-		return errDisabled
+	client, err := h.audioClient()
+	if err != nil {
+		return err
 	}
 
-	return h.audio.SinkMuteToggle(id)
+	return client.SinkMuteToggle(id)
 }
 
 func (h *host) AudioSourceVolumeAdjust(id string, direction eventv1.Direction) error {
-	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled {
-		return errDisabled
+	client, err := h.audioClient()
+	if err != nil {
+		return err
 	}
 
-	return h.audio.SourceVolumeAdjust(id, direction)
+	return client.SourceVolumeAdjust(id, direction)
 }
 
 func (h *host) AudioSourceMuteToggle(id string) error {
-	if h.cfg.Audio == nil || !h.cfg.Audio.Enabled {
-		return errDisabled
+	client, err := h.audioClient()
+	if err != nil {
+		return err
 	}
 
-	return h.audio.SourceMuteToggle(id)
+	return client.SourceMuteToggle(id)
 }
 
 func (h *host) BrightnessAdjust(devName string, direction eventv1.Direction) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.Brightness == nil || !h.cfg.Dbus.Brightness.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(brightnessEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.Brightness().Adjust(devName, direction)
+	return client.Brightness().Adjust(devName, direction)
 }
 
 func (h *host) IdleInhibitorInhibit(target eventv1.InhibitTarget) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.IdleInhibitor == nil || !h.cfg.Dbus.IdleInhibitor.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(idleInhibitorEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.IdleInhibitor().Inhibit(target)
+	return client.IdleInhibitor().Inhibit(target)
 }
 
 func (h *host) IdleInhibitorUninhibit(target eventv1.InhibitTarget) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.IdleInhibitor == nil || !h.cfg.Dbus.IdleInhibitor.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(idleInhibitorEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.IdleInhibitor().Uninhibit(target)
+	return client.IdleInhibitor().Uninhibit(target)
 }
 
 func (h *host) MediaPlayerPlayPause() error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.MediaPlayer == nil || !h.cfg.Dbus.MediaPlayer.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(mediaPlayerEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.MediaPlayer().PlayPause()
+	return client.MediaPlayer().PlayPause()
 }
 
 func (h *host) MediaPlayerPause() error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.MediaPlayer == nil || !h.cfg.Dbus.MediaPlayer.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(mediaPlayerEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.MediaPlayer().Pause()
+	return client.MediaPlayer().Pause()
 }
 
 func (h *host) MediaPlayerPlay() error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.MediaPlayer == nil || !h.cfg.Dbus.MediaPlayer.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(mediaPlayerEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.MediaPlayer().Play()
+	return client.MediaPlayer().Play()
 }
 
 func (h *host) MediaPlayerStop() error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.MediaPlayer == nil || !h.cfg.Dbus.MediaPlayer.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(mediaPlayerEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.MediaPlayer().Stop()
+	return client.MediaPlayer().Stop()
 }
 
 func (h *host) MediaPlayerNext() error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.MediaPlayer == nil || !h.cfg.Dbus.MediaPlayer.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(mediaPlayerEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.MediaPlayer().Next()
+	return client.MediaPlayer().Next()
 }
 
 func (h *host) MediaPlayerPrevious() error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.MediaPlayer == nil || !h.cfg.Dbus.MediaPlayer.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(mediaPlayerEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.MediaPlayer().Previous()
+	return client.MediaPlayer().Previous()
 }
 
 func (h *host) MediaPlayerSeek(offset int64) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.MediaPlayer == nil || !h.cfg.Dbus.MediaPlayer.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(mediaPlayerEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.MediaPlayer().Seek(offset)
+	return client.MediaPlayer().Seek(offset)
 }
 
 func (h *host) MediaPlayerSetPosition(trackId string, pos int64) error {
-	if h.cfg.Dbus == nil || !h.cfg.Dbus.Enabled || h.cfg.Dbus.MediaPlayer == nil || !h.cfg.Dbus.MediaPlayer.Enabled {
-		return errDisabled
+	client, err := h.dbusClient(mediaPlayerEnabled)
+	if err != nil {
+		return err
 	}
 
-	return h.dbus.MediaPlayer().SetPostion(trackId, pos)
+	return client.MediaPlayer().SetPostion(trackId, pos)
+}
+
+func (h *host) HyprQuery(query hyprpanelv1.HyprQuery) ([]byte, error) {
+	return h.hyprCache.Query(query)
+}
+
+func (h *host) HyprDispatch(args ...string) error {
+	return h.hyprCache.Dispatch(args...)
+}
+
+func (h *host) Capabilities() *hyprpanelv1.Capabilities {
+	return panelplugin.HostCapabilities()
 }
 
 func (h *host) CaptureFrame(address uint64, width, height int32) (*hyprpanelv1.ImageNRGBA, error) {
@@ -280,16 +398,25 @@ func (h *host) CaptureFrame(address uint64, width, height int32) (*hyprpanelv1.I
 	}, nil
 }
 
-func (h *host) runPanel(clientPath string, layerShellPath string, prevPreload string, id string, cfg *configv1.Panel) (panelplugin.Panel, *plugin.Client, error) {
+// panelLauncher starts panel client processes.
+type panelLauncher struct {
+	log            hclog.Logger
+	clientPath     string
+	layerShellPath string
+	prevPreload    string
+}
+
+// launch a panel client and initialize it with impl as its host.
+func (l *panelLauncher) launch(impl panelplugin.Host, id string, loglevel configv1.LogLevel, cfg *configv1.Panel, stylesheet []byte) (panelplugin.Panel, *plugin.Client, error) {
 	socketDir := os.Getenv(plugin.EnvUnixSocketDir)
 	if socketDir == `` {
-		if runDir := os.Getenv(`XDG_RUNTIME_DIR`); runDir != `` {
-			socketDir = filepath.Join(runDir, `hyprpanel`)
+		if runDir, err := runtimeDir(); err == nil {
+			socketDir = runDir
 			if err := os.MkdirAll(socketDir, 0o750); err != nil && err != os.ErrExist {
-				h.log.Warn(`Could not create socket dir`, `path`, socketDir, `err`, err)
+				l.log.Warn(`Could not create socket dir`, `path`, socketDir, `err`, err)
 			} else {
 				if err := os.Setenv(plugin.EnvUnixSocketDir, socketDir); err != nil {
-					h.log.Warn(`Could not set socket dir`, `env`, plugin.EnvUnixSocketDir, `path`, socketDir, `err`, err)
+					l.log.Warn(`Could not set socket dir`, `env`, plugin.EnvUnixSocketDir, `path`, socketDir, `err`, err)
 				}
 			}
 		}
@@ -297,57 +424,185 @@ func (h *host) runPanel(clientPath string, layerShellPath string, prevPreload st
 	client := plugin.NewClient(&plugin.ClientConfig{
 		HandshakeConfig:     panelplugin.Handshake,
 		Plugins:             panelplugin.PluginMap,
-		Cmd:                 exec.Command(clientPath),
+		Cmd:                 exec.Command(l.clientPath),
 		AllowedProtocols:    []plugin.Protocol{plugin.ProtocolGRPC},
-		Logger:              h.pluginLog.Named(id),
+		Logger:              l.log.Named(id),
 		Managed:             true,
 		GRPCBrokerMultiplex: true,
 	})
 
-	if err := os.Setenv(`LD_PRELOAD`, layerShellPath); err != nil {
+	if err := os.Setenv(`LD_PRELOAD`, l.layerShellPath); err != nil {
 		return nil, nil, fmt.Errorf(`failed to set LD_PRELOAD: %w`, err)
 	}
 	rpcClient, err := client.Client()
-	if err != nil {
-		return nil, nil, fmt.Errorf(`failed initializing client: %w`, err)
-	}
-	if err := os.Setenv(`LD_PRELOAD`, prevPreload); err != nil {
+	if err := os.Setenv(`LD_PRELOAD`, l.prevPreload); err != nil {
+		client.Kill()
 		return nil, nil, fmt.Errorf(`failed to restore LD_PRELOAD: %w`, err)
 	}
+	if err != nil {
+		client.Kill()
+		return nil, nil, fmt.Errorf("failed initializing client %s, check that it matches hyprpanel version %s: %w", l.clientPath, panelplugin.Version(), err)
+	}
 
 	raw, err := rpcClient.Dispense(panelplugin.PanelPluginName)
 	if err != nil {
+		client.Kill()
 		return nil, nil, fmt.Errorf(`failed dispensing client: %w`, err)
 	}
 
 	panel := raw.(panelplugin.Panel)
-	if err := panel.Init(h, id, h.cfg.LogLevel, cfg, h.stylesheet); err != nil {
+	if err := panel.Init(impl, id, loglevel, cfg, stylesheet); err != nil {
+		client.Kill()
 		return nil, nil, err
 	}
 
+	caps := panel.Capabilities()
+	if skew := panelplugin.VersionSkew(`hyprpanel-client`, caps); skew != `` {
+		l.log.Warn(`Version mismatch, some features may be unavailable`, `panelID`, id, `detail`, skew)
+	}
+	for _, mod := range cfg.Modules {
+		if name := panelplugin.ModuleName(mod); name != `` && !panelplugin.SupportsModule(caps, name) {
+			l.log.Warn(`Module not supported by hyprpanel-client, it will not be displayed`, `panelID`, id, `module`, name, `clientVersion`, caps.Version, `version`, panelplugin.Version())
+		}
+	}
+
 	return panel, client, nil
 }
 
+func newPanelLauncher(log hclog.Logger) (*panelLauncher, error) {
+	clientPath, err := findClient()
+	if err != nil {
+		return nil, fmt.Errorf("could not find client path: %w", err)
+	}
+
+	layerShellPath, err := findLayerShell()
+	if err != nil {
+		return nil, fmt.Errorf("could not find gtk4-layer-shell path: %w", err)
+	}
+
+	return &panelLauncher{
+		log:            log,
+		clientPath:     clientPath,
+		layerShellPath: layerShellPath,
+		prevPreload:    os.Getenv(`LD_PRELOAD`),
+	}, nil
+}
+
+func (h *host) reload(cfg *configv1.Config, styles *stylesheets) {
+	h.updateStyle(styles)
+	h.updateConfig(cfg)
+}
+
+// updateConfig queues cfg to be diffed against the running configuration. Only
+// the most recent pending configuration is retained.
 func (h *host) updateConfig(cfg *configv1.Config) {
-	h.cfg = cfg
-	h.reloadCh <- struct{}{}
+	h.pendingMu.Lock()
+	h.pendingCfg = cfg
+	h.pendingMu.Unlock()
+
+	select {
+	case h.configCh <- struct{}{}:
+	default:
+	}
+}
+
+func (h *host) takePendingConfig() *configv1.Config {
+	h.pendingMu.Lock()
+	defer h.pendingMu.Unlock()
+	cfg := h.pendingCfg
+	h.pendingCfg = nil
+	return cfg
+}
+
+// updateStyle queues styles to be applied to running panels. Only the most
+// recent pending styles are retained.
+func (h *host) updateStyle(styles *stylesheets) {
+	h.pendingMu.Lock()
+	h.pendingStyle = styles
+	h.pendingMu.Unlock()
+
+	select {
+	case h.styleCh <- struct{}{}:
+	default:
+	}
+}
+
+func (h *host) takePendingStyle() *stylesheets {
+	h.pendingMu.Lock()
+	defer h.pendingMu.Unlock()
+	styles := h.pendingStyle
+	h.pendingStyle = nil
+	return styles
+}
+
+// panelStylesheet returns the stylesheet for the panel configured by cfg, with
+// the theme palette for the current colour scheme applied.
+func (h *host) panelStylesheet(cfg *configv1.Panel) []byte {
+	h.styleMu.RLock()
+	defer h.styleMu.RUnlock()
+	return h.styles.forPanel(h.theme, h.colorScheme, cfg)
+}
+
+func (h *host) setStyles(styles *stylesheets) {
+	h.styleMu.Lock()
+	defer h.styleMu.Unlock()
+	h.styles = styles
+}
+
+func (h *host) setTheme(theme *configv1.Config_Theme) {
+	h.styleMu.Lock()
+	defer h.styleMu.Unlock()
+	h.theme = theme
+}
+
+// setColorScheme records the desktop colour scheme, and queues the stylesheet
+// to be reapplied for its palette.
+func (h *host) setColorScheme(colorScheme eventv1.ColorScheme) {
+	h.styleMu.Lock()
+	h.colorScheme = colorScheme
+	h.styleMu.Unlock()
+
+	select {
+	case h.styleCh <- struct{}{}:
+	default:
+	}
+}
+
+// applyStyle sends the current stylesheets to running panels whose stylesheet
+// has changed.
+func (h *host) applyStyle() {
+	if updated := h.panels.updateStyle(h.panelStylesheet); updated > 0 {
+		h.log.Info(`Applied stylesheet changes`, `panels`, updated)
+	}
+}
+
+func (h *host) startWatch() {
+	h.watchStopCh = make(chan struct{})
+	h.watchDoneCh = make(chan struct{})
+	go h.watch(h.watchStopCh, h.watchDoneCh)
 }
 
-func (h *host) updateStyle(stylesheet []byte) {
-	h.stylesheet = stylesheet
-	h.reloadCh <- struct{}{}
+func (h *host) stopWatch() {
+	if h.watchStopCh == nil {
+		return
+	}
+	close(h.watchStopCh)
+	<-h.watchDoneCh
+	h.watchStopCh, h.watchDoneCh = nil, nil
 }
 
-func (h *host) watch() {
+func (h *host) watch(stopCh <-chan struct{}, doneCh chan<- struct{}) {
+	defer close(doneCh)
+
 	for {
 		select {
-		case <-h.stopWatchCh:
+		case <-stopCh:
 			return
 		case <-h.quitCh:
 			return
 		default:
 			select {
-			case <-h.stopWatchCh:
+			case <-stopCh:
 				return
 			case <-h.quitCh:
 				return
@@ -357,15 +612,24 @@ func (h *host) watch() {
 					return
 				}
 				h.log.Trace(`Received hypr event`, `kind`, evt.Kind)
-				for _, panel := range h.panels {
-					panel.Notify(evt)
+				// Invalidate before delivery, panels query in response to events.
+				h.hyprCache.Invalidate()
+				h.tap.publish(evt)
+				switch evt.Kind {
+				case eventv1.EventKind_EVENT_KIND_HYPR_MONITORADDED, eventv1.EventKind_EVENT_KIND_HYPR_MONITORREMOVED:
+					select {
+					case h.monitorsCh <- struct{}{}:
+					default:
+					}
 				}
+				h.panels.notify(evt)
 			case evt, ok := <-h.dbusEvtCh:
 				if !ok || evt == nil {
 					h.log.Error(`Received from closed dbus event channel`)
 					return
 				}
 				h.log.Trace(`Received dbus event`, `kind`, evt.Kind)
+				h.tap.publish(evt)
 				switch evt.Kind {
 				case eventv1.EventKind_EVENT_KIND_AUDIO_SINK_VOLUME_ADJUST:
 					data := &eventv1.AudioSinkVolumeAdjust{}
@@ -432,6 +696,21 @@ func (h *host) watch() {
 					if err := h.BrightnessAdjust(data.DevName, data.Direction); err != nil {
 						h.log.Warn(`Brightness adjustment failed`, `err`, err)
 					}
+				case eventv1.EventKind_EVENT_KIND_DBUS_COLOR_SCHEME_CHANGE:
+					data := &eventv1.ColorSchemeValue{}
+					if !evt.Data.MessageIs(data) {
+						h.log.Warn(`Invalid event`, `evt`, evt)
+						continue
+					}
+					if err := evt.Data.UnmarshalTo(data); err != nil {
+						h.log.Warn(`Invalid event`, `evt`, evt, `err`, err)
+						continue
+					}
+					h.log.Debug(`Desktop color scheme changed`, `colorScheme`, data.ColorScheme)
+					h.setColorScheme(data.ColorScheme)
+					// Panels also receive the event, for visibility conditions.
+					h.state.update(stateSourceDBUS, evt)
+					h.panels.notify(evt)
 				case eventv1.EventKind_EVENT_KIND_EXEC:
 					data := &hyprpanelv1.AppInfo_Action{}
 					if !evt.Data.MessageIs(data) {
@@ -446,9 +725,8 @@ func (h *host) watch() {
 						h.log.Warn(`Exec failed`, `err`, err)
 					}
 				default:
-					for _, panel := range h.panels {
-						panel.Notify(evt)
-					}
+					h.state.update(stateSourceDBUS, evt)
+					h.panels.notify(evt)
 				}
 			case evt, ok := <-h.audioEvtCh:
 				if !ok || evt == nil {
@@ -456,16 +734,25 @@ func (h *host) watch() {
 					return
 				}
 				h.log.Trace(`Received audio event`, `kind`, evt.Kind)
-				for _, panel := range h.panels {
-					panel.Notify(evt)
-				}
+				h.tap.publish(evt)
+				h.state.update(stateSourceAudio, evt)
+				h.panels.notify(evt)
 			}
 		}
 	}
 }
 
 func (h *host) run() error {
-	if len(h.cfg.Panels) == 0 {
+	if cfg := h.takePendingConfig(); cfg != nil {
+		h.mu.Lock()
+		h.cfg = cfg
+		h.mu.Unlock()
+	}
+	if styles := h.takePendingStyle(); styles != nil {
+		h.setStyles(styles)
+	}
+	h.setTheme(h.cfg.Theme)
+	if len(h.cfg.Panels) == 0 && len(h.cfg.Profiles) == 0 {
 		return fmt.Errorf(`no panels configured`)
 	}
 
@@ -481,101 +768,193 @@ func (h *host) run() error {
 	h.log.SetLevel(hclog.Level(h.cfg.LogLevel))
 	h.pluginLog.SetLevel(hclog.Level(h.cfg.LogLevel))
 
-	clientPath, err := findClient()
+	launcher, err := newPanelLauncher(h.pluginLog)
 	if err != nil {
-		return fmt.Errorf("could not find client path: %w", err)
+		return err
 	}
 
-	layerShellPath, err := findLayerShell()
-	if err != nil {
-		return fmt.Errorf("could not find gtk4-layer-shell path: %w", err)
+	if err := h.loadApps(); err != nil {
+		return err
 	}
 
-	grp, errCtx := errgroup.WithContext(context.Background())
+	defer h.closeDBUS()
+	if err := h.connectDBUS(); err != nil {
+		return fmt.Errorf("DBUS connection failed: %w", err)
+	}
 
-	if h.apps != nil {
-		if err := h.apps.Close(); err != nil {
-			h.log.Error(`Failed to close app cache`, `err`, err)
-		}
+	defer h.closeAudio()
+	if err := h.connectAudio(); err != nil {
+		return fmt.Errorf("audio connection failed: %w", err)
 	}
 
-	apps, err := applications.New(h.log, h.cfg.IconOverrides)
+	h.panels = newSupervisor(h.log, func(id string, cfg *configv1.Panel) (panelplugin.Panel, *plugin.Client, []byte, error) {
+		stylesheet := h.panelStylesheet(cfg)
+		panel, client, err := launcher.launch(h, id, h.config().LogLevel, cfg, stylesheet)
+		return panel, client, stylesheet, err
+	}, h.state.snapshot)
+	panels, err := h.panelConfigs()
 	if err != nil {
-		return fmt.Errorf("app cache initialization failed: %w", err)
+		return err
 	}
-	h.apps = apps
+	h.panels.sync(panels, false)
 
-	if err := h.connectDBUS(); err != nil {
-		return fmt.Errorf("DBUS connection failed: %w", err)
-	}
-	if h.dbus != nil {
-		defer func() {
-			if err := h.dbus.Close(); err != nil {
-				h.log.Error(`Failed to close dbus client`, `err`, err)
+	h.startWatch()
+
+	for {
+		select {
+		case <-h.configCh:
+			cfg := h.takePendingConfig()
+			if cfg == nil {
+				continue
+			}
+			if err := h.applyConfig(cfg); err != nil {
+				h.stopWatch()
+				h.panels.stopAll()
+				return err
 			}
-		}()
+		case <-h.monitorsCh:
+			panels, err := h.panelConfigs()
+			if err != nil {
+				h.log.Warn(`Failed updating panels for monitor change`, `err`, err)
+				continue
+			}
+			started, stopped := h.panels.sync(panels, false)
+			h.log.Info(`Updated panels for monitor change`, `panelsStarted`, started, `panelsStopped`, stopped)
+		case <-h.styleCh:
+			if styles := h.takePendingStyle(); styles != nil {
+				h.setStyles(styles)
+			}
+			h.applyStyle()
+		case <-h.quitCh:
+			h.panels.stopAll()
+			if err := h.wl.Close(); err != nil {
+				h.log.Error(`Failed to close wl app`, `err`, err)
+			}
+			return nil
+		}
 	}
+}
 
-	if err := h.connectAudio(); err != nil {
-		return fmt.Errorf("audio connection failed: %w", err)
+// applyConfig diffs cfg against the running configuration, restarting only the
+// subsystems and panels that have changed.
+func (h *host) applyConfig(cfg *configv1.Config) error {
+	prev := h.cfg
+	restartDBUS := !proto.Equal(prev.Dbus, cfg.Dbus)
+	restartAudio := !proto.Equal(prev.Audio, cfg.Audio)
+	reloadApps := !slices.EqualFunc(prev.IconOverrides, cfg.IconOverrides, func(a, b *configv1.IconOverride) bool {
+		return proto.Equal(a, b)
+	})
+	// Panels receive the log level at initialization, so must all be restarted.
+	restartPanels := prev.LogLevel != cfg.LogLevel
+
+	if restartDBUS || restartAudio {
+		h.stopWatch()
 	}
-	if h.audio != nil {
-		defer func() {
-			if err := h.audio.Close(); err != nil {
-				h.log.Error(`Failed to close audio client`, `err`, err)
-			}
-		}()
+	// Subsystems are closed before the configuration is replaced, so that host
+	// methods never pair the new configuration with a client built from the
+	// old one.
+	if restartDBUS {
+		h.closeDBUS()
+	}
+	if restartAudio {
+		h.closeAudio()
 	}
 
-	prevPreload := os.Getenv(`LD_PRELOAD`)
-	h.panels = make([]panelplugin.Panel, len(h.cfg.Panels))
-	for i := range h.cfg.Panels {
-		cfg := h.cfg.Panels[i]
+	h.mu.Lock()
+	h.cfg = cfg
+	h.mu.Unlock()
+	h.log.SetLevel(hclog.Level(h.cfg.LogLevel))
+	h.pluginLog.SetLevel(hclog.Level(h.cfg.LogLevel))
+	h.setTheme(cfg.Theme)
 
-		panel, _, err := h.runPanel(clientPath, layerShellPath, prevPreload, cfg.Id, cfg)
-		if err != nil {
-			return fmt.Errorf("panel %s initialization failed: %w", cfg.Id, err)
+	if reloadApps {
+		if err := h.loadApps(); err != nil {
+			return err
 		}
-		h.panels[i] = panel
-		defer panel.Close()
+	}
+	if restartDBUS {
+		if err := h.connectDBUS(); err != nil {
+			return fmt.Errorf("DBUS connection failed: %w", err)
+		}
+	}
+	if restartAudio {
+		if err := h.connectAudio(); err != nil {
+			return fmt.Errorf("audio connection failed: %w", err)
+		}
+	}
+
+	if restartDBUS || restartAudio {
+		h.startWatch()
+	}
 
-		ctx := panel.Context()
-		grp.Go(func() error {
-			<-ctx.Done()
-			return fmt.Errorf("panel %s failed: %w", cfg.Id, panel.Context().Err())
-		})
+	panels, err := h.panelConfigs()
+	if err != nil {
+		return err
 	}
+	started, stopped := h.panels.sync(panels, restartPanels)
+	h.applyStyle()
 
-	go h.watch()
+	h.log.Info(`Applied configuration changes`, `dbus`, restartDBUS, `audio`, restartAudio, `apps`, reloadApps, `panelsStarted`, started, `panelsStopped`, stopped)
 
-	select {
-	case <-h.reloadCh:
-		h.stopWatchCh <- struct{}{}
-		for _, panel := range h.panels {
-			panel.Close()
-		}
-		h.panels = make([]panelplugin.Panel, 0)
-		return errReload
-	case <-errCtx.Done():
-		select {
-		case <-h.reloadCh:
-			return errReload
-		default:
-			return fmt.Errorf("panel failed (%w): %w", grp.Wait(), errCtx.Err())
+	return nil
+}
+
+// panelConfigs selects the layout profile for the connected monitors, and
+// resolves its panels against them.
+func (h *host) panelConfigs() ([]*configv1.Panel, error) {
+	monitors, err := h.hypr.Monitors()
+	if err != nil {
+		return nil, fmt.Errorf("failed querying monitors: %w", err)
+	}
+	names := make([]string, len(monitors))
+	for i, mon := range monitors {
+		names[i] = mon.Name
+	}
+
+	profile, panels := selectPanels(h.cfg, monitors, h.log)
+	if profile != h.profile || !h.profileSelected {
+		if profile == `` {
+			h.log.Info(`No layout profile matches connected monitors, using top-level panels`, `monitors`, names)
+		} else {
+			h.log.Info(`Selected layout profile`, `profile`, profile, `monitors`, names)
 		}
-	case <-h.quitCh:
-		if err := h.wl.Close(); err != nil {
-			h.log.Error(`Failed to close wl app`, `err`, err)
+		h.profile, h.profileSelected = profile, true
+	}
+
+	return expandPanels(panels, names, h.log), nil
+}
+
+func (h *host) loadApps() error {
+	apps, err := applications.New(h.log, h.cfg.IconOverrides)
+	if err != nil {
+		return fmt.Errorf("app cache initialization failed: %w", err)
+	}
+	h.mu.Lock()
+	prev := h.apps
+	h.apps = apps
+	h.mu.Unlock()
+
+	if prev != nil {
+		if err := prev.Close(); err != nil {
+			h.log.Error(`Failed to close app cache`, `err`, err)
 		}
-		return nil
 	}
+
+	return nil
 }
 
+// Close stops the host. It may be called more than once, e.g. by ctl quit
+// followed by a signal.
 func (h *host) Close() {
-	if err := h.apps.Close(); err != nil {
-		h.log.Error(`Failed to close app cache`, `err`, err)
-	}
-	close(h.quitCh)
+	h.closeOnce.Do(func() {
+		h.mu.RLock()
+		apps := h.apps
+		h.mu.RUnlock()
+		if err := apps.Close(); err != nil {
+			h.log.Error(`Failed to close app cache`, `err`, err)
+		}
+		close(h.quitCh)
+	})
 }
 
 func (h *host) connectHypr() (hypripc.CancelFunc, error) {
@@ -584,6 +963,7 @@ func (h *host) connectHypr() (hypripc.CancelFunc, error) {
 	if err != nil {
 		panic(err)
 	}
+	h.hyprCache = hypripc.NewCache(hypripc.NewSocketConn(h.log), hyprCacheMaxAge)
 	var cancel hypripc.CancelFunc
 	h.hyprEvtCh, cancel = h.hypr.Subscribe()
 	h.hypr.StartEvents()
@@ -596,10 +976,29 @@ func (h *host) connectDBUS() error {
 		return nil
 	}
 
-	var err error
-	h.dbus, h.dbusEvtCh, err = dbus.New(h.cfg.Dbus, h.log)
+	client, evtCh, err := dbus.New(h.cfg.Dbus, h.log)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	h.dbus, h.dbusEvtCh = client, evtCh
+	h.mu.Unlock()
+
+	return nil
+}
 
-	return err
+func (h *host) closeDBUS() {
+	h.mu.Lock()
+	client := h.dbus
+	h.dbus, h.dbusEvtCh = nil, nil
+	h.mu.Unlock()
+	if client == nil {
+		return
+	}
+	if err := client.Close(); err != nil {
+		h.log.Error(`Failed to close dbus client`, `err`, err)
+	}
+	h.state.clear(stateSourceDBUS)
 }
 
 func (h *host) connectAudio() error {
@@ -607,13 +1006,32 @@ func (h *host) connectAudio() error {
 		return nil
 	}
 
-	var err error
-	h.audio, h.audioEvtCh, err = audio.New(h.cfg.Audio, h.log)
+	client, evtCh, err := audio.New(h.cfg.Audio, h.log)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	h.audio, h.audioEvtCh = client, evtCh
+	h.mu.Unlock()
 
-	return err
+	return nil
+}
+
+func (h *host) closeAudio() {
+	h.mu.Lock()
+	client := h.audio
+	h.audio, h.audioEvtCh = nil, nil
+	h.mu.Unlock()
+	if client == nil {
+		return
+	}
+	if err := client.Close(); err != nil {
+		h.log.Error(`Failed to close audio client`, `err`, err)
+	}
+	h.state.clear(stateSourceAudio)
 }
 
-func newHost(cfg *configv1.Config, stylesheet []byte, log hclog.Logger) (*host, error) {
+func newHost(cfg *configv1.Config, styles *stylesheets, log hclog.Logger) (*host, error) {
 	var err error
 	wlApp, err := wl.NewApp(log)
 	if err != nil {
@@ -621,14 +1039,17 @@ func newHost(cfg *configv1.Config, stylesheet []byte, log hclog.Logger) (*host,
 	}
 
 	h := &host{
-		cfg:         cfg,
-		stylesheet:  stylesheet,
-		log:         log,
-		pluginLog:   log.Named(`plugin`),
-		wl:          wlApp,
-		reloadCh:    make(chan struct{}),
-		stopWatchCh: make(chan struct{}),
-		quitCh:      make(chan struct{}),
+		cfg:        cfg,
+		styles:     styles,
+		log:        log,
+		pluginLog:  log.Named(`plugin`),
+		wl:         wlApp,
+		state:      newStateStore(),
+		tap:        newEventTap(),
+		configCh:   make(chan struct{}, 1),
+		styleCh:    make(chan struct{}, 1),
+		monitorsCh: make(chan struct{}, 1),
+		quitCh:     make(chan struct{}),
 	}
 
 	return h, nil
@@ -2,12 +2,15 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"runtime/debug"
+	"slices"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -15,7 +18,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
 	"github.com/pdf/hyprpanel/config"
-	"github.com/pdf/hyprpanel/style"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
 	"github.com/peterbourgon/ff/v4"
 	"github.com/peterbourgon/ff/v4/ffhelp"
 	"golang.org/x/sys/unix"
@@ -71,6 +74,61 @@ func sigHandler(log hclog.Logger, h *host) {
 	}
 }
 
+// loadConfig loads and validates configFile, logging any warnings, or returns
+// the default configuration if it does not exist. The files that the
+// configuration was read from are returned for watching, even on error.
+func loadConfig(configFile string, log hclog.Logger) (*configv1.Config, []string, error) {
+	result, err := config.Load(configFile, connectedMonitors(log))
+	if os.IsNotExist(err) {
+		cfg, err := config.Default()
+		return cfg, []string{configFile}, err
+	}
+	if result == nil {
+		return nil, []string{configFile}, err
+	}
+	logDiagnostics(log, result.Diagnostics.Warnings())
+
+	return result.Config, result.Files, err
+}
+
+// migrateConfig upgrades outdated files of the configuration at configFile in
+// place, logging each migration applied, or required for files that must be
+// migrated by hand.
+func migrateConfig(configFile string, log hclog.Logger) {
+	if _, err := os.Stat(configFile); err != nil {
+		return
+	}
+	migrated, err := config.Migrate(configFile)
+	if err != nil {
+		log.Warn(`Failed migrating configuration`, `file`, configFile, `err`, err)
+	}
+	for _, file := range migrated {
+		for _, m := range file.Migrations {
+			if file.Manual {
+				log.Warn(`Configuration file requires manual migration`, `file`, file.File, `version`, m.Version, `migration`, m.Description)
+				continue
+			}
+			log.Info(`Migrated configuration file`, `file`, file.File, `version`, m.Version, `migration`, m.Description)
+		}
+		if file.Backup != `` {
+			log.Info(`Original configuration file backed up`, `file`, file.File, `backup`, file.Backup)
+		}
+	}
+}
+
+// logDiagnostics logs configuration diagnostics at a level matching their
+// severity.
+func logDiagnostics(log hclog.Logger, diags config.Diagnostics) {
+	for _, diag := range diags {
+		switch diag.Severity {
+		case config.SeverityError:
+			log.Error(`Invalid configuration`, `diagnostic`, diag.String())
+		default:
+			log.Warn(`Configuration warning`, `diagnostic`, diag.String())
+		}
+	}
+}
+
 func main() {
 	fs := ff.NewFlagSet(name)
 	var configPath string
@@ -80,19 +138,43 @@ func main() {
 	} else {
 		configPath = filepath.Join(xdgConfigPath, `hyprpanel`)
 	}
-	configFileDefault := filepath.Join(configPath, `config.json`)
-	configFile := fs.String('c', `config`, configFileDefault, `Path to configuration file`)
+	configFileDefault := config.Find(configPath)
+	configFile := fs.String('c', `config`, configFileDefault, `Path to configuration file (.json, .jsonc, .yaml, .yml or .toml)`)
 	styleFileDefault := filepath.Join(configPath, `style.css`)
 	styleFile := fs.String('s', `style`, styleFileDefault, `Path to stylesheet`)
 	version := fs.BoolLong(`version`, `Display the application version`)
 
-	log := hclog.New(&hclog.LoggerOptions{
-		Name:   `host`,
-		Output: os.Stdout,
-	})
+	cmd := &ff.Command{
+		Name:  name,
+		Usage: name + ` [FLAGS] [SUBCOMMAND]`,
+		Flags: fs,
+		Exec: func(_ context.Context, _ []string) error {
+			if *version {
+				info, ok := debug.ReadBuildInfo()
+				if !ok {
+					return fmt.Errorf("%s unknown version", name)
+				}
+				fmt.Printf("%s version %s built with %s\n", name, info.Main.Version, info.GoVersion)
+				return nil
+			}
+
+			// Without an explicit config file, any supported format in the
+			// config directory is picked up, including one created later.
+			discover := *configFile == configFileDefault
+			runHost(configPath, *configFile, discover, *styleFile)
+			return nil
+		},
+		Subcommands: []*ff.Command{
+			newCtlCommand(fs),
+			newRecordCommand(fs),
+			newReplayCommand(fs, configFile, styleFile),
+			newValidateCommand(fs, configFile),
+			newSchemaCommand(fs),
+		},
+	}
 
-	if err := ff.Parse(fs, os.Args[1:], ff.WithEnvVarPrefix(`HYPRPANEL`)); err != nil {
-		fmt.Printf("%s\n", ffhelp.Flags(fs))
+	if err := cmd.Parse(os.Args[1:], ff.WithEnvVarPrefix(`HYPRPANEL`)); err != nil {
+		fmt.Printf("%s\n", ffhelp.Command(cmd.GetSelected()))
 		if errors.Is(err, ff.ErrHelp) {
 			os.Exit(0)
 		}
@@ -101,33 +183,39 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := unix.Prctl(unix.PR_SET_CHILD_SUBREAPER, 1, 0, 0, 0); err != nil {
-		log.Error(`Failed setting child subreaper`, `err`, err)
+	if err := cmd.Run(context.Background()); err != nil {
+		if errors.Is(err, errCtlUsage) {
+			fmt.Printf("%s\n", ffhelp.Command(cmd.GetSelected()))
+		}
+		fmt.Printf("err=%v\n", err)
 		os.Exit(1)
 	}
+}
 
-	if *version {
-		info, ok := debug.ReadBuildInfo()
-		if !ok {
-			fmt.Printf("%s unknown version", name)
-			os.Exit(1)
-		}
-		fmt.Printf("%s version %s built with %s\n", name, info.Main.Version, info.GoVersion)
-		os.Exit(0)
+func runHost(configPath, configFile string, discover bool, styleFile string) {
+	log := hclog.New(&hclog.LoggerOptions{
+		Name:   `host`,
+		Output: os.Stdout,
+	})
+
+	if err := unix.Prctl(unix.PR_SET_CHILD_SUBREAPER, 1, 0, 0, 0); err != nil {
+		log.Error(`Failed setting child subreaper`, `err`, err)
+		os.Exit(1)
 	}
 
-	cfg, err := config.Load(*configFile)
+	cfg, files, err := loadConfig(configFile, log)
 	if err != nil {
-		if !errors.Is(err, os.ErrNotExist) {
-			log.Error(`Failed loading configuration file`, `file`, *configFile, `err`, err)
-			os.Exit(1)
-		}
-		log.Warn(`Failed loading configuration file, creating with defaults`, `file`, *configFile)
-		cfg, err = config.Default()
-		if err != nil {
-			log.Error(`Failed loading default configuration file`, `err`, err)
-			os.Exit(1)
+		var diags config.Diagnostics
+		if errors.As(err, &diags) {
+			logDiagnostics(log, diags)
+			err = fmt.Errorf("%d configuration errors, run `%s validate` for details", len(diags), name)
 		}
+		log.Error(`Failed loading configuration file`, `file`, configFile, `err`, err)
+		os.Exit(1)
+	}
+	migrateConfig(configFile, log)
+	if _, err := os.Stat(configFile); errors.Is(err, os.ErrNotExist) {
+		log.Warn(`Failed loading configuration file, creating with defaults`, `file`, configFile)
 		if err := os.MkdirAll(configPath, 0o755); err != nil && err != os.ErrExist {
 			log.Error(`Failed creating configuration directory`, `path`, configPath, `err`, err)
 			os.Exit(1)
@@ -145,24 +233,24 @@ func main() {
 			os.Exit(1)
 		}
 
-		if err := os.WriteFile(*configFile, b, 0o644); err != nil {
-			log.Error(`Failed writing default configuration file`, `file`, *configFile, `err`, err)
+		if err := os.WriteFile(configFile, b, 0o644); err != nil {
+			log.Error(`Failed writing default configuration file`, `file`, configFile, `err`, err)
 			os.Exit(1)
 		}
 	}
 
 	log.SetLevel(hclog.Level(cfg.LogLevel))
 
-	stylesheet, err := style.Load(*styleFile)
+	styles, styleFiles, err := loadStyles(styleFile, cfg)
 	if err != nil {
-		if !errors.Is(err, os.ErrNotExist) {
-			log.Error(`Failed loading stylesheet`, `file`, *styleFile, `err`, err)
-			os.Exit(1)
-		}
-		log.Warn(`Failed loading stylesheet, continuing with defaults`, `file`, *styleFile)
+		log.Error(`Failed loading stylesheet`, `file`, styleFile, `err`, err)
+		os.Exit(1)
+	}
+	if _, err := os.Stat(styleFile); errors.Is(err, os.ErrNotExist) {
+		log.Warn(`Failed loading stylesheet, continuing with defaults`, `file`, styleFile)
 	}
 
-	h, err := newHost(cfg, stylesheet, log)
+	h, err := newHost(cfg, styles, log)
 	if err != nil {
 		log.Error(`Failed initializing hyprpanel`, `err`, err)
 		os.Exit(1)
@@ -180,6 +268,49 @@ func main() {
 		}
 	}()
 
+	watch := newConfigWatch(watcher, log)
+	styleWatch := newConfigWatch(watcher, log)
+	// current holds the most recently loaded configuration, which selects the
+	// panel stylesheets.
+	var current atomic.Pointer[configv1.Config]
+	current.Store(cfg)
+
+	resolveConfig := func() string {
+		if discover {
+			return config.Find(configPath)
+		}
+		return configFile
+	}
+	isConfig := func(name string) bool {
+		if discover && slices.Contains(config.Candidates(configPath), name) {
+			return true
+		}
+		return watch.has(name)
+	}
+
+	reload := func() error {
+		cfg, files, err := loadConfig(resolveConfig(), log)
+		watch.set(files)
+		if err != nil {
+			return fmt.Errorf("failed reloading config: %w", err)
+		}
+		styles, styleFiles, err := loadStyles(styleFile, cfg)
+		styleWatch.set(styleFiles)
+		if err != nil {
+			return fmt.Errorf("failed reloading stylesheet: %w", err)
+		}
+		log.SetLevel(hclog.Level(cfg.LogLevel))
+		current.Store(cfg)
+		h.reload(cfg, styles)
+		return nil
+	}
+	ctl, err := newControl(h, h.tap, reload, h.Close, log.Named(`control`))
+	if err != nil {
+		log.Warn(`Failed starting control socket, hyprpanel ctl will be unavailable`, `err`, err)
+	} else {
+		defer ctl.Close()
+	}
+
 	go func() {
 		for {
 			select {
@@ -187,27 +318,39 @@ func main() {
 				if !evt.Has(fsnotify.Write) && !evt.Has(fsnotify.Create) && !evt.Has(fsnotify.Remove) {
 					continue
 				}
-				switch evt.Name {
-				case *configFile:
-					cfg, err := config.Load(*configFile)
-					if os.IsNotExist(err) {
-						cfg, err = config.Default()
-					}
+				switch {
+				case isConfig(evt.Name):
+					cfg, files, err := loadConfig(resolveConfig(), log)
+					watch.set(files)
 					if err != nil {
+						var diags config.Diagnostics
+						if errors.As(err, &diags) {
+							logDiagnostics(log, diags)
+							log.Error(`Failed reloading config, keeping previous configuration`, `errors`, len(diags))
+							continue
+						}
 						log.Error(`Failed reloading config`, `err`, err)
 						continue
 					}
 					log.SetLevel(hclog.Level(cfg.LogLevel))
-					h.updateConfig(cfg)
-				case *styleFile:
-					stylesheet, err := style.Load(*styleFile)
-					if os.IsNotExist(err) {
-						stylesheet = style.Default
-					} else if err != nil {
+					current.Store(cfg)
+					// Panel stylesheets are configured, so may have changed.
+					styles, styleFiles, err := loadStyles(styleFile, cfg)
+					styleWatch.set(styleFiles)
+					if err != nil {
 						log.Error(`Failed reloading stylesheet`, `err`, err)
+						h.updateConfig(cfg)
 						continue
 					}
-					h.updateStyle(stylesheet)
+					h.reload(cfg, styles)
+				case styleWatch.has(evt.Name):
+					styles, styleFiles, err := loadStyles(styleFile, current.Load())
+					styleWatch.set(styleFiles)
+					if err != nil {
+						log.Error(`Failed reloading stylesheet`, `err`, err)
+						continue
+					}
+					h.updateStyle(styles)
 				}
 			case err := <-watcher.Errors:
 				if err != nil {
@@ -218,25 +361,20 @@ func main() {
 		}
 	}()
 
-	configDir := filepath.Dir(*configFile)
-	styleDir := filepath.Dir(*styleFile)
+	configDir := filepath.Dir(configFile)
 	if err := watcher.Add(configDir); err != nil {
 		log.Error(`Failed adding filesystem watch path`, `path`, configDir, `err`, err)
 		os.Exit(1)
 	}
-	if configDir != styleDir {
-		if err := watcher.Add(styleDir); err != nil {
-			log.Error(`Failed adding filesystem watch path`, `path`, styleDir, `err`, err)
-			os.Exit(1)
-		}
-	}
+	watch.set(files)
+	styleWatch.set(styleFiles)
 	defer plugin.CleanupClients()
 
 	count := 0
 	timer := time.NewTimer(crashTimeout)
 	for {
 		err := h.run()
-		if err != nil && err != errReload {
+		if err != nil {
 			count += 1
 			log.Error(`Clients failed`, `err`, err)
 			select {
@@ -251,10 +389,8 @@ func main() {
 			}
 			time.Sleep(crashRetry)
 			continue
-		} else if err != nil && err == errReload {
-			continue
 		}
 
-		os.Exit(0)
+		return
 	}
 }
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"path"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	monitorListSeparator = `,`
+	monitorIDSeparator   = `@`
+	monitorGlobChars     = `*?[`
+)
+
+// monitorPatterns splits a Panel.monitor selector into its component patterns.
+func monitorPatterns(selector string) []string {
+	parts := strings.Split(selector, monitorListSeparator)
+	patterns := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part != `` {
+			patterns = append(patterns, part)
+		}
+	}
+
+	return patterns
+}
+
+// expandPanels resolves the monitor selector for each panel against the
+// connected monitors. Panels naming a single monitor are returned unmodified
+// when that monitor is connected, and omitted otherwise. Panels with a pattern
+// or list selector are cloned once per matching monitor, with the monitor name
+// appended to the panel ID.
+func expandPanels(panels []*configv1.Panel, monitors []string, log hclog.Logger) []*configv1.Panel {
+	result := make([]*configv1.Panel, 0, len(panels))
+	for _, panel := range panels {
+		if panel.Monitor == `` {
+			result = append(result, panel)
+			continue
+		}
+
+		patterns := monitorPatterns(panel.Monitor)
+		multi := len(patterns) > 1 || strings.ContainsAny(panel.Monitor, monitorGlobChars)
+		for _, name := range monitors {
+			if !matchMonitor(patterns, name, log) {
+				continue
+			}
+			if !multi {
+				result = append(result, panel)
+				break
+			}
+
+			clone := proto.Clone(panel).(*configv1.Panel)
+			clone.Id = panel.Id + monitorIDSeparator + name
+			clone.Monitor = name
+			result = append(result, clone)
+		}
+	}
+
+	return result
+}
+
+// connectedMonitors returns the names of the monitors connected to Hyprland, or
+// nil if Hyprland is unavailable.
+func connectedMonitors(log hclog.Logger) []string {
+	monitors, err := hypripc.NewControl(hypripc.NewSocketConn(log)).Monitors()
+	if err != nil {
+		log.Debug(`Could not query monitors`, `err`, err)
+		return nil
+	}
+	names := make([]string, len(monitors))
+	for i, mon := range monitors {
+		names[i] = mon.Name
+	}
+
+	return names
+}
+
+func matchMonitor(patterns []string, name string, log hclog.Logger) bool {
+	for _, pattern := range patterns {
+		ok, err := path.Match(pattern, name)
+		if err != nil {
+			log.Warn(`Invalid monitor pattern`, `pattern`, pattern, `err`, err)
+			continue
+		}
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectPanels returns the panels of the first profile in cfg matching
+// monitors, with selectors naming rule IDs resolved to the matched monitor, or
+// the top-level panels and an empty profile name if none match.
+func selectPanels(cfg *configv1.Config, monitors []hypripc.Monitor, log hclog.Logger) (string, []*configv1.Panel) {
+	for _, profile := range cfg.Profiles {
+		matched, ok := matchProfile(profile, monitors, log)
+		if !ok {
+			continue
+		}
+		panels := make([]*configv1.Panel, len(profile.Panels))
+		for i, panel := range profile.Panels {
+			panels[i] = resolveMonitorIDs(panel, matched)
+		}
+		return profile.Name, panels
+	}
+
+	return ``, cfg.Panels
+}
+
+// matchProfile assigns a distinct monitor to each rule in profile, returning
+// the monitor name for each rule ID.
+func matchProfile(profile *configv1.Profile, monitors []hypripc.Monitor, log hclog.Logger) (map[string]string, bool) {
+	if profile.Exact && len(profile.Monitors) != len(monitors) {
+		return nil, false
+	}
+
+	assigned := make([]int, len(profile.Monitors))
+	used := make([]bool, len(monitors))
+	// assign backtracks, as a monitor taken by a broad rule may be the only
+	// match for a later, more specific one.
+	var assign func(rule int) bool
+	assign = func(rule int) bool {
+		if rule == len(profile.Monitors) {
+			return true
+		}
+		for i := range monitors {
+			if used[i] || !matchMonitorRule(profile.Monitors[rule], &monitors[i], log) {
+				continue
+			}
+			used[i], assigned[rule] = true, i
+			if assign(rule + 1) {
+				return true
+			}
+			used[i] = false
+		}
+		return false
+	}
+	if !assign(0) {
+		return nil, false
+	}
+
+	matched := make(map[string]string, len(profile.Monitors))
+	for rule, i := range assigned {
+		if id := profile.Monitors[rule].Id; id != `` {
+			matched[id] = monitors[i].Name
+		}
+	}
+
+	return matched, true
+}
+
+func matchMonitorRule(rule *configv1.MonitorMatch, mon *hypripc.Monitor, log hclog.Logger) bool {
+	for _, f := range []struct {
+		pattern, value string
+	}{
+		{rule.Name, mon.Name},
+		{rule.Make, mon.Make},
+		{rule.Model, mon.Model},
+		{rule.Serial, mon.Serial},
+	} {
+		if f.pattern == `` {
+			continue
+		}
+		if !matchMonitor([]string{f.pattern}, f.value, log) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resolveMonitorIDs returns panel with any selector patterns that name a
+// matched rule ID replaced by the monitor name.
+func resolveMonitorIDs(panel *configv1.Panel, matched map[string]string) *configv1.Panel {
+	if len(matched) == 0 || panel.Monitor == `` {
+		return panel
+	}
+
+	patterns := monitorPatterns(panel.Monitor)
+	resolved := false
+	for i, pattern := range patterns {
+		if name, ok := matched[pattern]; ok {
+			patterns[i] = name
+			resolved = true
+		}
+	}
+	if !resolved {
+		return panel
+	}
+
+	clone := proto.Clone(panel).(*configv1.Panel)
+	clone.Monitor = strings.Join(patterns, monitorListSeparator)
+
+	return clone
+}
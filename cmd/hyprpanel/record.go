@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"github.com/peterbourgon/ff/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	eventTapBufferSize = 1024
+	// snapshotInterval is the interval between Hyprland snapshots while
+	// recording, to capture changes that emit no event, e.g. window moves.
+	snapshotInterval = time.Second
+)
+
+// hyprSnapshotQueries lists the queries captured in a HyprSnapshot.
+var hyprSnapshotQueries = []hyprpanelv1.HyprQuery{
+	hyprpanelv1.HyprQuery_HYPR_QUERY_CLIENTS,
+	hyprpanelv1.HyprQuery_HYPR_QUERY_MONITORS,
+	hyprpanelv1.HyprQuery_HYPR_QUERY_WORKSPACES,
+	hyprpanelv1.HyprQuery_HYPR_QUERY_ACTIVE_WINDOW,
+	hyprpanelv1.HyprQuery_HYPR_QUERY_ACTIVE_WORKSPACE,
+}
+
+// hyprSnapshotField returns the field of snapshot holding the response to
+// query, or nil if query is not captured.
+func hyprSnapshotField(snapshot *eventv1.HyprSnapshot, query hyprpanelv1.HyprQuery) *[]byte {
+	switch query {
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_CLIENTS:
+		return &snapshot.Clients
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_MONITORS:
+		return &snapshot.Monitors
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_WORKSPACES:
+		return &snapshot.Workspaces
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_ACTIVE_WINDOW:
+		return &snapshot.ActiveWindow
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_ACTIVE_WORKSPACE:
+		return &snapshot.ActiveWorkspace
+	default:
+		return nil
+	}
+}
+
+// takeHyprSnapshot queries conn for the state served to panels during replay.
+func takeHyprSnapshot(conn hypripc.Conn) (*eventv1.HyprSnapshot, error) {
+	snapshot := &eventv1.HyprSnapshot{}
+	for _, query := range hyprSnapshotQueries {
+		b, err := conn.Query(query)
+		if err != nil {
+			return nil, fmt.Errorf("failed querying %s: %w", query, err)
+		}
+		*hyprSnapshotField(snapshot, query) = b
+	}
+
+	return snapshot, nil
+}
+
+// hyprSnapshotEqual returns true if a and b hold the same responses.
+func hyprSnapshotEqual(a, b *eventv1.HyprSnapshot) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	for _, query := range hyprSnapshotQueries {
+		if !bytes.Equal(*hyprSnapshotField(a, query), *hyprSnapshotField(b, query)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isHyprEvent returns true if evt originates from Hyprland, and so may change
+// the responses to Hyprland queries.
+func isHyprEvent(evt *eventv1.Event) bool {
+	return strings.HasPrefix(evt.GetKind().String(), `EVENT_KIND_HYPR_`)
+}
+
+// tapSubscriber receives a copy of every event published to an eventTap.
+type tapSubscriber struct {
+	ch      chan *eventv1.RecordedEvent
+	dropped atomic.Uint64
+}
+
+// eventTap publishes the merged hypr, dbus and audio event stream to
+// subscribers, for recording.
+type eventTap struct {
+	mu   sync.RWMutex
+	subs map[*tapSubscriber]struct{}
+}
+
+// publish evt to all subscribers without blocking, dropping the event for any
+// subscriber that is not keeping up.
+func (t *eventTap) publish(evt *eventv1.Event) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if len(t.subs) == 0 {
+		return
+	}
+
+	rec := &eventv1.RecordedEvent{
+		Timestamp: timestamppb.Now(),
+		Event:     evt,
+	}
+	for sub := range t.subs {
+		select {
+		case sub.ch <- rec:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
+
+// subscribe to the event stream, the returned function must be called to
+// release the subscription.
+func (t *eventTap) subscribe() (*tapSubscriber, func()) {
+	sub := &tapSubscriber{
+		ch: make(chan *eventv1.RecordedEvent, eventTapBufferSize),
+	}
+
+	t.mu.Lock()
+	t.subs[sub] = struct{}{}
+	t.mu.Unlock()
+
+	return sub, func() {
+		t.mu.Lock()
+		delete(t.subs, sub)
+		t.mu.Unlock()
+	}
+}
+
+func newEventTap() *eventTap {
+	return &eventTap{
+		subs: make(map[*tapSubscriber]struct{}),
+	}
+}
+
+func newRecordCommand(parent *ff.FlagSet) *ff.Command {
+	fs := ff.NewFlagSet(`record`).SetParent(parent)
+	output := fs.String('o', `output`, ``, `Path to write the recording to, or - for stdout`)
+
+	return &ff.Command{
+		Name:      `record`,
+		Usage:     name + ` record -o FILE`,
+		ShortHelp: `record the event stream of a running hyprpanel instance`,
+		LongHelp:  `Events are written as length-delimited hyprpanel.event.v1.RecordedEvent messages until interrupted, along with snapshots of Hyprland state for panels to query during replay.`,
+		Flags:     fs,
+		Exec: func(ctx context.Context, _ []string) error {
+			if *output == `` {
+				return fmt.Errorf("%w: missing output file", errCtlUsage)
+			}
+			return runRecord(ctx, *output)
+		},
+	}
+}
+
+func runRecord(ctx context.Context, output string) error {
+	log := hclog.New(&hclog.LoggerOptions{
+		Name:   `record`,
+		Output: os.Stderr,
+	})
+
+	path, err := controlSocketPath()
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.NewClient(`unix://`+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	ctx, cancel := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	stream, err := hyprpanelv1.NewControlServiceClient(conn).Events(ctx, &hyprpanelv1.ControlServiceEventsRequest{})
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if output != `-` {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Error(`Failed closing recording`, `file`, output, `err`, err)
+			}
+		}()
+		w = f
+	}
+	buf := bufio.NewWriter(w)
+	defer func() {
+		if err := buf.Flush(); err != nil {
+			log.Error(`Failed flushing recording`, `err`, err)
+		}
+	}()
+
+	recvCh := make(chan *eventv1.RecordedEvent)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case recvCh <- resp.Event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	hypr := hypripc.NewSocketConn(log)
+	var snapshot *eventv1.HyprSnapshot
+	// writeSnapshot records the Hyprland state if it has changed since the
+	// previous snapshot.
+	writeSnapshot := func(ts *timestamppb.Timestamp) error {
+		next, err := takeHyprSnapshot(hypr)
+		if err != nil {
+			return err
+		}
+		if hyprSnapshotEqual(next, snapshot) {
+			return nil
+		}
+		snapshot = next
+		if _, err := protodelim.MarshalTo(buf, &eventv1.RecordedEvent{Timestamp: ts, HyprSnapshot: snapshot}); err != nil {
+			return fmt.Errorf("failed writing snapshot: %w", err)
+		}
+		return nil
+	}
+	snapshots := true
+	if err := writeSnapshot(timestamppb.Now()); err != nil {
+		log.Warn(`Failed snapshotting Hyprland state, panels will query the live compositor during replay`, `err`, err)
+		snapshots = false
+	}
+	ticker := time.NewTicker(snapshotInterval)
+	defer ticker.Stop()
+
+	log.Info(`Recording events, interrupt to stop`, `output`, output)
+	count := 0
+	for {
+		select {
+		case err := <-errCh:
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+				log.Info(`Recording complete`, `events`, count)
+				return nil
+			}
+			return err
+		case <-ctx.Done():
+			log.Info(`Recording complete`, `events`, count)
+			return nil
+		case <-ticker.C:
+			if !snapshots {
+				continue
+			}
+			if err := writeSnapshot(timestamppb.Now()); err != nil {
+				log.Warn(`Failed snapshotting Hyprland state`, `err`, err)
+			}
+		case rec := <-recvCh:
+			// The snapshot precedes the event, so that it is served to
+			// panels querying in response to the event.
+			if snapshots && isHyprEvent(rec.Event) {
+				if err := writeSnapshot(rec.Timestamp); err != nil {
+					log.Warn(`Failed snapshotting Hyprland state`, `err`, err)
+				}
+			}
+			if _, err := protodelim.MarshalTo(buf, rec); err != nil {
+				return fmt.Errorf("failed writing event: %w", err)
+			}
+			count += 1
+		}
+	}
+}
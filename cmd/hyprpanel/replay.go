@@ -0,0 +1,380 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"github.com/pdf/hyprpanel/internal/applications"
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	"github.com/pdf/hyprpanel/internal/panelplugin"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"github.com/peterbourgon/ff/v4"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// snapshotConn serves Hyprland queries from the most recent snapshot in a
+// recording, falling back to conn for recordings without snapshots.
+type snapshotConn struct {
+	conn hypripc.Conn
+
+	mu       sync.RWMutex
+	snapshot *eventv1.HyprSnapshot
+}
+
+// Query implementation.
+func (s *snapshotConn) Query(query hyprpanelv1.HyprQuery) ([]byte, error) {
+	s.mu.RLock()
+	snapshot := s.snapshot
+	s.mu.RUnlock()
+	if snapshot == nil {
+		return s.conn.Query(query)
+	}
+	field := hyprSnapshotField(snapshot, query)
+	if field == nil {
+		return nil, fmt.Errorf("unsupported query: %s", query)
+	}
+
+	return *field, nil
+}
+
+// Dispatch implementation, dispatches are never performed during replay.
+func (s *snapshotConn) Dispatch(_ ...string) error {
+	return errDisabled
+}
+
+func (s *snapshotConn) set(snapshot *eventv1.HyprSnapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshot = snapshot
+}
+
+// replayHost is a stand-in panel host for replaying recordings. Requests that
+// would have side effects are logged rather than performed.
+type replayHost struct {
+	log  hclog.Logger
+	apps *applications.AppCache
+	hypr hypripc.Conn
+}
+
+func (r *replayHost) Exec(action *hyprpanelv1.AppInfo_Action) error {
+	r.log.Info(`Exec`, `exec`, action.Exec)
+	return nil
+}
+
+func (r *replayHost) FindApplication(query string) (*hyprpanelv1.AppInfo, error) {
+	return r.apps.Find(query), nil
+}
+
+func (r *replayHost) SystrayActivate(busName string, x, y int32) error {
+	r.log.Info(`SystrayActivate`, `busName`, busName, `x`, x, `y`, y)
+	return nil
+}
+
+func (r *replayHost) SystraySecondaryActivate(busName string, x, y int32) error {
+	r.log.Info(`SystraySecondaryActivate`, `busName`, busName, `x`, x, `y`, y)
+	return nil
+}
+
+func (r *replayHost) SystrayScroll(busName string, delta int32, orientation hyprpanelv1.SystrayScrollOrientation) error {
+	r.log.Info(`SystrayScroll`, `busName`, busName, `delta`, delta, `orientation`, orientation)
+	return nil
+}
+
+func (r *replayHost) SystrayMenuContextActivate(busName string, x, y int32) error {
+	r.log.Info(`SystrayMenuContextActivate`, `busName`, busName, `x`, x, `y`, y)
+	return nil
+}
+
+func (r *replayHost) SystrayMenuAboutToShow(busName string, menuItemID string) error {
+	r.log.Info(`SystrayMenuAboutToShow`, `busName`, busName, `menuItemID`, menuItemID)
+	return nil
+}
+
+func (r *replayHost) SystrayMenuEvent(busName string, id int32, eventID hyprpanelv1.SystrayMenuEvent, data any, timestamp time.Time) error {
+	r.log.Info(`SystrayMenuEvent`, `busName`, busName, `id`, id, `eventID`, eventID, `data`, data, `timestamp`, timestamp)
+	return nil
+}
+
+func (r *replayHost) NotificationClosed(id uint32, reason hyprpanelv1.NotificationClosedReason) error {
+	r.log.Info(`NotificationClosed`, `id`, id, `reason`, reason)
+	return nil
+}
+
+func (r *replayHost) NotificationAction(id uint32, actionKey string) error {
+	r.log.Info(`NotificationAction`, `id`, id, `actionKey`, actionKey)
+	return nil
+}
+
+func (r *replayHost) AudioSinkVolumeAdjust(id string, direction eventv1.Direction) error {
+	r.log.Info(`AudioSinkVolumeAdjust`, `id`, id, `direction`, direction)
+	return nil
+}
+
+func (r *replayHost) AudioSinkMuteToggle(id string) error {
+	r.log.Info(`AudioSinkMuteToggle`, `id`, id)
+	return nil
+}
+
+func (r *replayHost) AudioSourceVolumeAdjust(id string, direction eventv1.Direction) error {
+	r.log.Info(`AudioSourceVolumeAdjust`, `id`, id, `direction`, direction)
+	return nil
+}
+
+func (r *replayHost) AudioSourceMuteToggle(id string) error {
+	r.log.Info(`AudioSourceMuteToggle`, `id`, id)
+	return nil
+}
+
+func (r *replayHost) BrightnessAdjust(devName string, direction eventv1.Direction) error {
+	r.log.Info(`BrightnessAdjust`, `devName`, devName, `direction`, direction)
+	return nil
+}
+
+func (r *replayHost) CaptureFrame(address uint64, width, height int32) (*hyprpanelv1.ImageNRGBA, error) {
+	r.log.Debug(`CaptureFrame`, `address`, address, `width`, width, `height`, height)
+	return nil, errDisabled
+}
+
+func (r *replayHost) IdleInhibitorInhibit(target eventv1.InhibitTarget) error {
+	r.log.Info(`IdleInhibitorInhibit`, `target`, target)
+	return nil
+}
+
+func (r *replayHost) IdleInhibitorUninhibit(target eventv1.InhibitTarget) error {
+	r.log.Info(`IdleInhibitorUninhibit`, `target`, target)
+	return nil
+}
+
+func (r *replayHost) MediaPlayerPlayPause() error {
+	r.log.Info(`MediaPlayerPlayPause`)
+	return nil
+}
+
+func (r *replayHost) MediaPlayerPlay() error {
+	r.log.Info(`MediaPlayerPlay`)
+	return nil
+}
+
+func (r *replayHost) MediaPlayerPause() error {
+	r.log.Info(`MediaPlayerPause`)
+	return nil
+}
+
+func (r *replayHost) MediaPlayerStop() error {
+	r.log.Info(`MediaPlayerStop`)
+	return nil
+}
+
+func (r *replayHost) MediaPlayerNext() error {
+	r.log.Info(`MediaPlayerNext`)
+	return nil
+}
+
+func (r *replayHost) MediaPlayerPrevious() error {
+	r.log.Info(`MediaPlayerPrevious`)
+	return nil
+}
+
+func (r *replayHost) MediaPlayerSeek(offset int64) error {
+	r.log.Info(`MediaPlayerSeek`, `offset`, offset)
+	return nil
+}
+
+func (r *replayHost) MediaPlayerSetPosition(trackId string, pos int64) error {
+	r.log.Info(`MediaPlayerSetPosition`, `trackId`, trackId, `pos`, pos)
+	return nil
+}
+
+func (r *replayHost) HyprQuery(query hyprpanelv1.HyprQuery) ([]byte, error) {
+	return r.hypr.Query(query)
+}
+
+func (r *replayHost) HyprDispatch(args ...string) error {
+	r.log.Info(`HyprDispatch`, `args`, args)
+	return nil
+}
+
+func (r *replayHost) Capabilities() *hyprpanelv1.Capabilities {
+	return panelplugin.HostCapabilities()
+}
+
+func newReplayCommand(parent *ff.FlagSet, configFile, styleFile *string) *ff.Command {
+	fs := ff.NewFlagSet(`replay`).SetParent(parent)
+	speed := fs.Float64Long(`speed`, 1, `Playback speed multiplier, 0 replays without delay`)
+	wait := fs.DurationLong(`wait`, 2*time.Second, `Delay before playback starts, allowing panels to initialize`)
+
+	return &ff.Command{
+		Name:      `replay`,
+		Usage:     name + ` replay [FLAGS] FILE`,
+		ShortHelp: `replay a recorded event stream into panels`,
+		LongHelp:  `Panels are started from the configuration and stylesheet, and fed events from a recording made with the record subcommand. Host requests from panels are logged rather than performed.`,
+		Flags:     fs,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) != 1 || *speed < 0 {
+				return errCtlUsage
+			}
+			return runReplay(ctx, *configFile, *styleFile, args[0], *speed, *wait)
+		},
+	}
+}
+
+func runReplay(ctx context.Context, configFile, styleFile, recording string, speed float64, wait time.Duration) error {
+	log := hclog.New(&hclog.LoggerOptions{
+		Name:   `replay`,
+		Output: os.Stdout,
+	})
+
+	cfg, _, err := loadConfig(configFile, log)
+	if err != nil {
+		return fmt.Errorf("failed loading config: %w", err)
+	}
+	log.SetLevel(hclog.Level(cfg.LogLevel))
+	styles, _, err := loadStyles(styleFile, cfg)
+	if err != nil {
+		return fmt.Errorf("failed loading stylesheet: %w", err)
+	}
+
+	f, err := os.Open(recording)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	r := bufio.NewReader(f)
+	hypr := &snapshotConn{conn: hypripc.NewSocketConn(log)}
+
+	// Snapshots preceding the first event describe the state panels start
+	// with, so are applied before launch.
+	var pending *eventv1.RecordedEvent
+	count := 0
+	for pending == nil {
+		rec := &eventv1.RecordedEvent{}
+		if err := protodelim.UnmarshalFrom(r, rec); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("failed reading event %d: %w", count+1, err)
+		}
+		if rec.HyprSnapshot != nil {
+			hypr.set(rec.HyprSnapshot)
+		}
+		if rec.Event != nil {
+			pending = rec
+		}
+	}
+	if hypr.snapshot == nil {
+		log.Warn(`Recording has no Hyprland snapshots, panels will query the live compositor`)
+	}
+
+	apps, err := applications.New(log, cfg.IconOverrides)
+	if err != nil {
+		return fmt.Errorf("app cache initialization failed: %w", err)
+	}
+	defer func() {
+		if err := apps.Close(); err != nil {
+			log.Error(`Failed to close app cache`, `err`, err)
+		}
+	}()
+
+	pluginLog := log.Named(`plugin`)
+	pluginLog.SetLevel(hclog.Level(cfg.LogLevel))
+	launcher, err := newPanelLauncher(pluginLog)
+	if err != nil {
+		return err
+	}
+	defer plugin.CleanupClients()
+
+	impl := &replayHost{log: log.Named(`host`), apps: apps, hypr: hypr}
+	panels := newSupervisor(log, func(id string, panelCfg *configv1.Panel) (panelplugin.Panel, *plugin.Client, []byte, error) {
+		stylesheet := styles.forPanel(cfg.Theme, eventv1.ColorScheme_COLOR_SCHEME_UNSPECIFIED, panelCfg)
+		panel, client, err := launcher.launch(impl, id, cfg.LogLevel, panelCfg, stylesheet)
+		return panel, client, stylesheet, err
+	}, func() []*eventv1.Event { return nil })
+	defer panels.stopAll()
+	panels.sync(replayPanels(cfg, hypr, log), false)
+
+	ctx, cancel := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-time.After(wait):
+	}
+
+	log.Info(`Replaying events`, `file`, recording, `speed`, speed)
+	var prev time.Time
+	for {
+		rec := pending
+		pending = nil
+		if rec == nil {
+			rec = &eventv1.RecordedEvent{}
+			if err := protodelim.UnmarshalFrom(r, rec); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return fmt.Errorf("failed reading event %d: %w", count+1, err)
+			}
+		}
+
+		ts := rec.Timestamp.AsTime()
+		if !prev.IsZero() && speed > 0 {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(time.Duration(float64(ts.Sub(prev)) / speed)):
+			}
+		}
+		prev = ts
+
+		if rec.HyprSnapshot != nil {
+			hypr.set(rec.HyprSnapshot)
+		}
+		if rec.Event == nil {
+			continue
+		}
+		log.Debug(`Replaying event`, `kind`, rec.Event.Kind, `timestamp`, ts)
+		panels.notify(rec.Event)
+		count += 1
+	}
+
+	log.Info(`Replay complete, interrupt to exit`, `events`, count)
+	<-ctx.Done()
+
+	return nil
+}
+
+// replayPanels selects the layout profile and resolves its panels against the
+// recorded monitors, or the connected monitors for recordings without
+// snapshots, when available. Otherwise the top-level panels are returned
+// unmodified.
+func replayPanels(cfg *configv1.Config, conn hypripc.Conn, log hclog.Logger) []*configv1.Panel {
+	monitors, err := hypripc.NewControl(conn).Monitors()
+	if err != nil {
+		log.Warn(`Failed querying monitors, monitor selectors will not be resolved`, `err`, err)
+		return cfg.Panels
+	}
+	names := make([]string, len(monitors))
+	for i, mon := range monitors {
+		names[i] = mon.Name
+	}
+	profile, panels := selectPanels(cfg, monitors, log)
+	if profile != `` {
+		log.Info(`Selected layout profile`, `profile`, profile)
+	}
+
+	return expandPanels(panels, names, log)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pdf/hyprpanel/config"
+	"github.com/peterbourgon/ff/v4"
+)
+
+func newSchemaCommand(parent *ff.FlagSet) *ff.Command {
+	fs := ff.NewFlagSet(`schema`).SetParent(parent)
+
+	return &ff.Command{
+		Name:      `schema`,
+		Usage:     name + ` schema [FLAGS]`,
+		ShortHelp: `print a JSON Schema for the configuration file`,
+		LongHelp:  `A JSON Schema (draft 2020-12) describing the configuration file is written to stdout, including field descriptions and the accepted enum values, for validation and completion in editors.`,
+		Flags:     fs,
+		Exec: func(_ context.Context, args []string) error {
+			if len(args) != 0 {
+				return errCtlUsage
+			}
+			b, err := config.Schema()
+			if err != nil {
+				return fmt.Errorf("failed generating schema: %w", err)
+			}
+			fmt.Println(string(b))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pdf/hyprpanel/internal/eventqueue"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+)
+
+const (
+	stateSourceDBUS  = `dbus`
+	stateSourceAudio = `audio`
+)
+
+type stateEntry struct {
+	seq uint64
+	evt *eventv1.Event
+}
+
+// stateStore records the last known state events emitted by the host
+// subsystems, so that newly started panels may be brought up to date without
+// waiting for the next change.
+type stateStore struct {
+	mu      sync.RWMutex
+	seq     uint64
+	entries map[string]stateEntry
+}
+
+// update the store with evt from source, ignoring events that do not describe
+// state.
+func (s *stateStore) update(source string, evt *eventv1.Event) {
+	switch evt.Kind {
+	case eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER:
+		v := &eventv1.StatusNotifierValue{}
+		if err := evt.Data.UnmarshalTo(v); err != nil {
+			return
+		}
+		key := source + `/sni/` + v.BusName
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.removeLocked(key)
+		s.setLocked(key, evt)
+	case eventv1.EventKind_EVENT_KIND_DBUS_UNREGISTERSTATUSNOTIFIER:
+		busName, err := eventv1.DataString(evt.Data)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.removeLocked(source + `/sni/` + busName)
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATETOOLTIP,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATEICON,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATESTATUS,
+		eventv1.EventKind_EVENT_KIND_DBUS_UPDATEMENU:
+		busName, ok := statusNotifierBusName(evt)
+		if !ok {
+			return
+		}
+		itemKey := source + `/sni/` + busName
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		// Updates are only meaningful following registration.
+		if _, ok := s.entries[itemKey]; !ok {
+			return
+		}
+		s.setLocked(itemKey+`/`+evt.Kind.String(), evt)
+	case eventv1.EventKind_EVENT_KIND_IDLE_INHIBITOR_INHIBIT, eventv1.EventKind_EVENT_KIND_IDLE_INHIBITOR_UNINHIBIT:
+		v := &eventv1.IdleInhibitorValue{}
+		if err := evt.Data.UnmarshalTo(v); err != nil {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.setLocked(source+`/idle/`+v.Target.String(), evt)
+	case eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE:
+		v := &eventv1.MediaPlayerValueChange{}
+		if err := evt.Data.UnmarshalTo(v); err != nil {
+			return
+		}
+		key := source + `/` + eventqueue.CoalesceKey(evt)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		// A stopped change without identity or track follows the exit of the
+		// last player, leaving nothing to replay.
+		if v.State == eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_STOPPED && v.Identity == nil && v.TrackId == `` {
+			s.removeLocked(key)
+			return
+		}
+		s.setLocked(key, evt)
+	default:
+		key := eventqueue.CoalesceKey(evt)
+		if key == `` {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.setLocked(source+`/`+key, evt)
+	}
+}
+
+// clear all state recorded from source.
+func (s *stateStore) clear(source string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeLocked(source)
+}
+
+// snapshot returns the recorded state events, in the order they were received.
+func (s *stateStore) snapshot() []*eventv1.Event {
+	s.mu.RLock()
+	entries := make([]stateEntry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, e)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].seq < entries[j].seq
+	})
+	events := make([]*eventv1.Event, len(entries))
+	for i, e := range entries {
+		events[i] = e.evt
+	}
+
+	return events
+}
+
+func (s *stateStore) setLocked(key string, evt *eventv1.Event) {
+	s.seq += 1
+	s.entries[key] = stateEntry{seq: s.seq, evt: evt}
+}
+
+// removeLocked deletes key and any keys nested beneath it.
+func (s *stateStore) removeLocked(key string) {
+	for k := range s.entries {
+		if k == key || strings.HasPrefix(k, key+`/`) {
+			delete(s.entries, k)
+		}
+	}
+}
+
+func statusNotifierBusName(evt *eventv1.Event) (string, bool) {
+	switch evt.Kind {
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE:
+		v := &eventv1.UpdateTitleValue{}
+		if err := evt.Data.UnmarshalTo(v); err != nil {
+			return ``, false
+		}
+		return v.BusName, true
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATETOOLTIP:
+		v := &eventv1.UpdateTooltipValue{}
+		if err := evt.Data.UnmarshalTo(v); err != nil {
+			return ``, false
+		}
+		return v.BusName, true
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATEICON:
+		v := &eventv1.UpdateIconValue{}
+		if err := evt.Data.UnmarshalTo(v); err != nil {
+			return ``, false
+		}
+		return v.BusName, true
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATESTATUS:
+		v := &eventv1.UpdateStatusValue{}
+		if err := evt.Data.UnmarshalTo(v); err != nil {
+			return ``, false
+		}
+		return v.BusName, true
+	case eventv1.EventKind_EVENT_KIND_DBUS_UPDATEMENU:
+		v := &eventv1.UpdateMenuValue{}
+		if err := evt.Data.UnmarshalTo(v); err != nil {
+			return ``, false
+		}
+		return v.BusName, true
+	default:
+		return ``, false
+	}
+}
+
+func newStateStore() *stateStore {
+	return &stateStore{
+		entries: make(map[string]stateEntry),
+	}
+}
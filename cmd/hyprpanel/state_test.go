@@ -0,0 +1,113 @@
+package main
+
+import (
+	"testing"
+
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newTestEvent(t *testing.T, kind eventv1.EventKind, data proto.Message) *eventv1.Event {
+	t.Helper()
+	v, err := anypb.New(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &eventv1.Event{Kind: kind, Data: v}
+}
+
+func assertSnapshot(t *testing.T, s *stateStore, want ...*eventv1.Event) {
+	t.Helper()
+	got := s.snapshot()
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("event %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestStateStoreStatusNotifier(t *testing.T) {
+	s := newStateStore()
+
+	update := newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE, &eventv1.UpdateTitleValue{BusName: `:1.1`, Title: `Early`})
+	s.update(stateSourceDBUS, update)
+	assertSnapshot(t, s)
+
+	register := newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER, &eventv1.StatusNotifierValue{BusName: `:1.1`, Title: `Test`})
+	other := newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER, &eventv1.StatusNotifierValue{BusName: `:1.2`, Title: `Other`})
+	s.update(stateSourceDBUS, register)
+	s.update(stateSourceDBUS, other)
+	assertSnapshot(t, s, register, other)
+
+	title := newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE, &eventv1.UpdateTitleValue{BusName: `:1.1`, Title: `Updated`})
+	status := newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_UPDATESTATUS, &eventv1.UpdateStatusValue{BusName: `:1.1`, Status: modulev1.Systray_STATUS_NEEDS_ATTENTION})
+	s.update(stateSourceDBUS, title)
+	s.update(stateSourceDBUS, status)
+	assertSnapshot(t, s, register, other, title, status)
+
+	retitle := newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE, &eventv1.UpdateTitleValue{BusName: `:1.1`, Title: `Again`})
+	s.update(stateSourceDBUS, retitle)
+	assertSnapshot(t, s, register, other, status, retitle)
+
+	// Registering again replaces the item along with its updates.
+	reregister := newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER, &eventv1.StatusNotifierValue{BusName: `:1.1`, Title: `Restarted`})
+	s.update(stateSourceDBUS, reregister)
+	assertSnapshot(t, s, other, reregister)
+
+	s.update(stateSourceDBUS, title)
+	unregister, err := eventv1.NewString(eventv1.EventKind_EVENT_KIND_DBUS_UNREGISTERSTATUSNOTIFIER, `:1.1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.update(stateSourceDBUS, unregister)
+	assertSnapshot(t, s, other)
+}
+
+func TestStateStoreMediaPlayer(t *testing.T) {
+	s := newStateStore()
+
+	identity := `Player`
+	playing := newTestEvent(t, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, &eventv1.MediaPlayerValueChange{
+		State:    eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_PLAYING,
+		TrackId:  `/track/1`,
+		Identity: &identity,
+	})
+	stopped := newTestEvent(t, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, &eventv1.MediaPlayerValueChange{
+		State:    eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_STOPPED,
+		Identity: &identity,
+	})
+	noPlayer := newTestEvent(t, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, &eventv1.MediaPlayerValueChange{
+		State: eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_STOPPED,
+	})
+
+	s.update(stateSourceDBUS, playing)
+	assertSnapshot(t, s, playing)
+
+	// A stopped player is still state worth replaying.
+	s.update(stateSourceDBUS, stopped)
+	assertSnapshot(t, s, stopped)
+
+	s.update(stateSourceDBUS, noPlayer)
+	assertSnapshot(t, s)
+
+	s.update(stateSourceDBUS, playing)
+	assertSnapshot(t, s, playing)
+}
+
+func TestStateStoreClear(t *testing.T) {
+	s := newStateStore()
+
+	register := newTestEvent(t, eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER, &eventv1.StatusNotifierValue{BusName: `:1.1`})
+	sink := newTestEvent(t, eventv1.EventKind_EVENT_KIND_AUDIO_SINK_CHANGE, &eventv1.AudioSinkChangeValue{Id: `sink`})
+	s.update(stateSourceDBUS, register)
+	s.update(stateSourceAudio, sink)
+	assertSnapshot(t, s, register, sink)
+
+	s.clear(stateSourceDBUS)
+	assertSnapshot(t, s, sink)
+}
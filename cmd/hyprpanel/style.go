@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"github.com/pdf/hyprpanel/style"
+)
+
+// stylesheets holds the main stylesheet, and the per-panel stylesheets by their
+// configured path.
+type stylesheets struct {
+	main   []byte
+	panels map[string][]byte
+}
+
+// forPanel returns the stylesheet for the panel configured by cfg: the main
+// stylesheet with the theme palette for colorScheme applied, followed by the
+// panel stylesheet, if any.
+func (s *stylesheets) forPanel(theme *configv1.Config_Theme, colorScheme eventv1.ColorScheme, cfg *configv1.Panel) []byte {
+	if s == nil {
+		return themeStylesheet(theme, colorScheme, nil)
+	}
+	stylesheet := themeStylesheet(theme, colorScheme, s.main)
+	panel, ok := s.panels[cfg.GetStylesheet()]
+	if !ok {
+		return stylesheet
+	}
+
+	return append(append(slices.Clip(stylesheet), '\n'), panel...)
+}
+
+// loadStyle loads the main stylesheet, or the default stylesheet if it does
+// not exist, returning the files read.
+func loadStyle(styleFile string) ([]byte, []string, error) {
+	stylesheet, files, err := style.Load(styleFile)
+	if errors.Is(err, os.ErrNotExist) && len(files) == 1 {
+		return style.Default, files, nil
+	}
+
+	return stylesheet, files, err
+}
+
+// loadStyles loads the main stylesheet, and the per-panel stylesheets
+// configured in cfg, whose relative paths are resolved against the directory of
+// styleFile. Panel stylesheets that do not exist are skipped. All files read are
+// returned for watching, also on failure.
+func loadStyles(styleFile string, cfg *configv1.Config) (*stylesheets, []string, error) {
+	main, files, err := loadStyle(styleFile)
+	if err != nil {
+		return nil, files, err
+	}
+
+	s := &stylesheets{
+		main:   main,
+		panels: make(map[string][]byte),
+	}
+	panels := slices.Clone(cfg.Panels)
+	for _, profile := range cfg.Profiles {
+		panels = append(panels, profile.Panels...)
+	}
+	for _, panel := range panels {
+		path := panel.Stylesheet
+		if _, ok := s.panels[path]; ok || path == `` {
+			continue
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(styleFile), path)
+		}
+		b, panelFiles, err := style.Load(path)
+		files = append(files, panelFiles...)
+		if errors.Is(err, os.ErrNotExist) && len(panelFiles) == 1 {
+			continue
+		}
+		if err != nil {
+			return nil, files, fmt.Errorf("failed loading stylesheet for panel %s: %w", panel.Id, err)
+		}
+		s.panels[panel.Stylesheet] = b
+	}
+
+	return s, files, nil
+}
@@ -0,0 +1,343 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"github.com/pdf/hyprpanel/internal/eventqueue"
+	"github.com/pdf/hyprpanel/internal/panelplugin"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	panelBackoffMin    = 200 * time.Millisecond
+	panelBackoffMax    = 30 * time.Second
+	panelStableTimeout = 30 * time.Second
+	// panelDrainTimeout limits the wait for in-flight events to be delivered
+	// to a panel that is being stopped.
+	panelDrainTimeout = 5 * time.Second
+	panelQueueSize    = 256
+	panelBatchSize    = 64
+)
+
+// launchFunc launches the panel configured by cfg, returning it along with the
+// stylesheet that it was initialized with.
+type launchFunc func(id string, cfg *configv1.Panel) (panelplugin.Panel, *plugin.Client, []byte, error)
+
+// supervisedPanel manages the lifecycle of a single panel client process,
+// restarting it with exponential backoff when it fails.
+type supervisedPanel struct {
+	id      string
+	cfg     *configv1.Panel
+	log     hclog.Logger
+	crashes int
+	queue   *eventqueue.Queue
+
+	mu     sync.RWMutex
+	panel  panelplugin.Panel
+	kinds  map[eventv1.EventKind]struct{}
+	stopCh chan struct{}
+	doneCh chan struct{}
+	// style is the stylesheet that the running panel was launched with, or
+	// most recently updated to, guarded by mu.
+	style []byte
+}
+
+func (p *supervisedPanel) current() panelplugin.Panel {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.panel
+}
+
+// setCurrent sets the running panel and the stylesheet it was launched with,
+// and applies its event subscriptions.
+func (p *supervisedPanel) setCurrent(panel panelplugin.Panel, style []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.panel = panel
+	p.kinds = nil
+	p.style = style
+	if panel == nil {
+		return
+	}
+	if filter := panel.EventFilter(); filter != nil {
+		p.kinds = make(map[eventv1.EventKind]struct{}, len(filter.Kinds))
+		for _, kind := range filter.Kinds {
+			p.kinds[kind] = struct{}{}
+		}
+		p.log.Debug(`Panel subscribed to events`, `kinds`, len(p.kinds))
+	}
+}
+
+// updateStyle applies stylesheet to the running panel, unless the panel already
+// uses it, returning true if it was applied.
+func (p *supervisedPanel) updateStyle(stylesheet []byte) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.panel == nil || bytes.Equal(stylesheet, p.style) {
+		return false, nil
+	}
+	if err := p.panel.UpdateStyle(stylesheet); err != nil {
+		return false, err
+	}
+	p.style = stylesheet
+
+	return true, nil
+}
+
+// wants returns true if the current panel subscribes to events of kind. Events
+// are accepted while no panel is running, since they are cleared on launch.
+func (p *supervisedPanel) wants(kind eventv1.EventKind) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.kinds == nil {
+		return true
+	}
+	_, ok := p.kinds[kind]
+	return ok
+}
+
+// notify queues evt for delivery if the panel subscribes to it.
+func (p *supervisedPanel) notify(evt *eventv1.Event) {
+	if p.wants(evt.Kind) {
+		p.queue.Push(evt)
+	}
+}
+
+// deliver replays the state snapshot to a newly initialized panel, followed by
+// queued events in batches until stopCh is closed.
+func (p *supervisedPanel) deliver(panel panelplugin.Panel, snapshot []*eventv1.Event, stopCh <-chan struct{}) {
+	p.log.Debug(`Replaying state to panel`, `events`, len(snapshot))
+	batch := make([]*eventv1.Event, 0, panelBatchSize)
+	for _, evt := range snapshot {
+		if !p.wants(evt.Kind) {
+			continue
+		}
+		batch = append(batch, evt)
+		if len(batch) == panelBatchSize {
+			panel.NotifyBatch(batch)
+			batch = batch[:0]
+		}
+		select {
+		case <-stopCh:
+			return
+		default:
+		}
+	}
+	if len(batch) > 0 {
+		panel.NotifyBatch(batch)
+	}
+
+	p.queue.RunBatch(stopCh, p.log, panelBatchSize, panel.NotifyBatch)
+}
+
+func (p *supervisedPanel) run(launch launchFunc, replay func() []*eventv1.Event) {
+	defer close(p.doneCh)
+
+	backoff := panelBackoffMin
+	for {
+		started := time.Now()
+		panel, client, style, err := launch(p.id, p.cfg)
+		if err == nil {
+			// Events queued before the panel was ready are superseded by the
+			// snapshot, which must be taken after the queue is cleared, and
+			// the panel's subscriptions are applied.
+			p.setCurrent(panel, style)
+			p.queue.Clear()
+			deliverStopCh, deliverDoneCh := make(chan struct{}), make(chan struct{})
+			go func() {
+				defer close(deliverDoneCh)
+				p.deliver(panel, replay(), deliverStopCh)
+			}()
+			select {
+			case <-p.stopCh:
+				close(deliverStopCh)
+				p.setCurrent(nil, nil)
+				select {
+				case <-deliverDoneCh:
+				case <-time.After(panelDrainTimeout):
+					// Closing the panel aborts a blocked delivery.
+					p.log.Warn(`Timed out delivering events to panel, closing`)
+				}
+				panel.Close()
+				client.Kill()
+				<-deliverDoneCh
+				return
+			case <-panel.Context().Done():
+				close(deliverStopCh)
+				p.setCurrent(nil, nil)
+				// The client has exited, so delivery fails without blocking.
+				<-deliverDoneCh
+				client.Kill()
+				err = fmt.Errorf("client exited: %w", panel.Context().Err())
+			}
+		}
+
+		if time.Since(started) > panelStableTimeout {
+			backoff = panelBackoffMin
+		}
+		p.crashes += 1
+		p.log.Error(`Panel failed, restarting`, `crashes`, p.crashes, `backoff`, backoff, `err`, err)
+
+		select {
+		case <-p.stopCh:
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > panelBackoffMax {
+			backoff = panelBackoffMax
+		}
+	}
+}
+
+// supervisor tracks all running panels for a host.
+type supervisor struct {
+	log    hclog.Logger
+	launch launchFunc
+	replay func() []*eventv1.Event
+
+	launchMu sync.Mutex
+	mu       sync.RWMutex
+	panels   map[string]*supervisedPanel
+}
+
+// start a supervised panel, replacing any existing panel with the same ID.
+func (s *supervisor) start(cfg *configv1.Panel) {
+	s.stop(cfg.Id)
+
+	p := &supervisedPanel{
+		id:     cfg.Id,
+		cfg:    cfg,
+		log:    s.log.With(`panel`, cfg.Id),
+		queue:  eventqueue.New(panelQueueSize),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	s.panels[cfg.Id] = p
+	s.mu.Unlock()
+
+	go p.run(func(id string, cfg *configv1.Panel) (panelplugin.Panel, *plugin.Client, []byte, error) {
+		// Launches modify process environment, so must not run concurrently.
+		s.launchMu.Lock()
+		defer s.launchMu.Unlock()
+		return s.launch(id, cfg)
+	}, s.replay)
+}
+
+// stop the panel with the specified ID and wait for it to exit.
+func (s *supervisor) stop(id string) {
+	s.mu.Lock()
+	p, ok := s.panels[id]
+	delete(s.panels, id)
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	close(p.stopCh)
+	<-p.doneCh
+}
+
+// sync the running panels to match panels, starting any that are new or have
+// changed, and stopping any that are no longer present. When restart is true,
+// all panels are restarted regardless of changes.
+func (s *supervisor) sync(panels []*configv1.Panel, restart bool) (started, stopped int) {
+	s.mu.RLock()
+	current := make(map[string]*configv1.Panel, len(s.panels))
+	for id, p := range s.panels {
+		current[id] = p.cfg
+	}
+	s.mu.RUnlock()
+
+	for _, cfg := range panels {
+		prev, ok := current[cfg.Id]
+		delete(current, cfg.Id)
+		if ok && !restart && proto.Equal(prev, cfg) {
+			continue
+		}
+		s.start(cfg)
+		started += 1
+	}
+	for id := range current {
+		s.stop(id)
+		stopped += 1
+	}
+
+	return started, stopped
+}
+
+// stopAll panels and wait for them to exit.
+func (s *supervisor) stopAll() {
+	s.mu.RLock()
+	ids := make([]string, 0, len(s.panels))
+	for id := range s.panels {
+		ids = append(ids, id)
+	}
+	s.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.stop(id)
+		}()
+	}
+	wg.Wait()
+}
+
+// notify all panels subscribed to an event, queueing it for delivery without
+// blocking.
+func (s *supervisor) notify(evt *eventv1.Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, p := range s.panels {
+		p.notify(evt)
+	}
+}
+
+// updateStyle applies the stylesheet returned for each running panel, if it
+// differs from the stylesheet last applied, restarting any panel that fails to
+// apply it. The number of panels updated is returned.
+func (s *supervisor) updateStyle(stylesheet func(cfg *configv1.Panel) []byte) int {
+	s.mu.RLock()
+	updated := 0
+	failed := make([]*configv1.Panel, 0)
+	for _, p := range s.panels {
+		ok, err := p.updateStyle(stylesheet(p.cfg))
+		if err != nil {
+			p.log.Warn(`Failed updating stylesheet, restarting`, `err`, err)
+			failed = append(failed, p.cfg)
+			continue
+		}
+		if ok {
+			updated++
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, cfg := range failed {
+		s.start(cfg)
+	}
+
+	return updated
+}
+
+func newSupervisor(log hclog.Logger, launch launchFunc, replay func() []*eventv1.Event) *supervisor {
+	return &supervisor{
+		log:    log,
+		launch: launch,
+		replay: replay,
+		panels: make(map[string]*supervisedPanel),
+	}
+}
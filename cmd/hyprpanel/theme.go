@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+)
+
+// themePalette returns the palette of theme selected for colorScheme, or nil if
+// none is configured.
+func themePalette(theme *configv1.Config_Theme, colorScheme eventv1.ColorScheme) *configv1.Palette {
+	name := theme.GetPalette()
+	switch colorScheme {
+	case eventv1.ColorScheme_COLOR_SCHEME_DARK:
+		if theme.GetDark() != `` {
+			name = theme.GetDark()
+		}
+	case eventv1.ColorScheme_COLOR_SCHEME_LIGHT:
+		if theme.GetLight() != `` {
+			name = theme.GetLight()
+		}
+	}
+	if name == `` {
+		return nil
+	}
+
+	return theme.GetPalettes()[name]
+}
+
+// themeStylesheet prepends the palette selected for colorScheme to stylesheet
+// as GTK CSS named colours. The user stylesheet is loaded at a higher priority
+// than the default, so palette colours override those of the default
+// stylesheet, and may in turn be redefined by the user stylesheet.
+func themeStylesheet(theme *configv1.Config_Theme, colorScheme eventv1.ColorScheme, stylesheet []byte) []byte {
+	palette := themePalette(theme, colorScheme)
+	if len(palette.GetColors()) == 0 {
+		return stylesheet
+	}
+
+	var buf bytes.Buffer
+	for _, name := range slices.Sorted(maps.Keys(palette.Colors)) {
+		fmt.Fprintf(&buf, "@define-color %s %s;\n", name, palette.Colors[name])
+	}
+	buf.Write(stylesheet)
+
+	return buf.Bytes()
+}
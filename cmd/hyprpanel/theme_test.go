@@ -0,0 +1,82 @@
+package main
+
+import (
+	"testing"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+)
+
+func TestThemeStylesheet(t *testing.T) {
+	palettes := map[string]*configv1.Palette{
+		`base`:  {Colors: map[string]string{`Highlight`: `#6b52a6`, `Background`: `#202020`}},
+		`dark`:  {Colors: map[string]string{`Highlight`: `#8a75c4`}},
+		`light`: {Colors: map[string]string{`Highlight`: `#4a3585`}},
+		`empty`: {},
+	}
+	stylesheet := []byte("window { color: @Highlight; }\n")
+
+	tests := []struct {
+		name        string
+		theme       *configv1.Config_Theme
+		colorScheme eventv1.ColorScheme
+		want        string
+	}{
+		{
+			name:        `no theme`,
+			colorScheme: eventv1.ColorScheme_COLOR_SCHEME_DARK,
+			want:        "window { color: @Highlight; }\n",
+		},
+		{
+			name:        `no preference`,
+			theme:       &configv1.Config_Theme{Palettes: palettes, Palette: `base`, Dark: `dark`, Light: `light`},
+			colorScheme: eventv1.ColorScheme_COLOR_SCHEME_UNSPECIFIED,
+			want:        "@define-color Background #202020;\n@define-color Highlight #6b52a6;\nwindow { color: @Highlight; }\n",
+		},
+		{
+			name:        `dark`,
+			theme:       &configv1.Config_Theme{Palettes: palettes, Palette: `base`, Dark: `dark`, Light: `light`},
+			colorScheme: eventv1.ColorScheme_COLOR_SCHEME_DARK,
+			want:        "@define-color Highlight #8a75c4;\nwindow { color: @Highlight; }\n",
+		},
+		{
+			name:        `light`,
+			theme:       &configv1.Config_Theme{Palettes: palettes, Palette: `base`, Dark: `dark`, Light: `light`},
+			colorScheme: eventv1.ColorScheme_COLOR_SCHEME_LIGHT,
+			want:        "@define-color Highlight #4a3585;\nwindow { color: @Highlight; }\n",
+		},
+		{
+			name:        `dark falls back to palette`,
+			theme:       &configv1.Config_Theme{Palettes: palettes, Palette: `base`, Light: `light`},
+			colorScheme: eventv1.ColorScheme_COLOR_SCHEME_DARK,
+			want:        "@define-color Background #202020;\n@define-color Highlight #6b52a6;\nwindow { color: @Highlight; }\n",
+		},
+		{
+			name:        `light without palette`,
+			theme:       &configv1.Config_Theme{Palettes: palettes, Dark: `dark`},
+			colorScheme: eventv1.ColorScheme_COLOR_SCHEME_LIGHT,
+			want:        "window { color: @Highlight; }\n",
+		},
+		{
+			name:        `unknown palette`,
+			theme:       &configv1.Config_Theme{Palettes: palettes, Palette: `missing`},
+			colorScheme: eventv1.ColorScheme_COLOR_SCHEME_UNSPECIFIED,
+			want:        "window { color: @Highlight; }\n",
+		},
+		{
+			name:        `empty palette`,
+			theme:       &configv1.Config_Theme{Palettes: palettes, Palette: `empty`},
+			colorScheme: eventv1.ColorScheme_COLOR_SCHEME_UNSPECIFIED,
+			want:        "window { color: @Highlight; }\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := themeStylesheet(tt.theme, tt.colorScheme, stylesheet)
+			if string(got) != tt.want {
+				t.Fatalf("got stylesheet:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
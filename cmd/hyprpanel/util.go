@@ -11,6 +11,24 @@ import (
 	"strings"
 )
 
+func runtimeDir() (string, error) {
+	runDir := os.Getenv(`XDG_RUNTIME_DIR`)
+	if runDir == `` {
+		return ``, errors.New(`XDG_RUNTIME_DIR not set`)
+	}
+
+	return filepath.Join(runDir, name), nil
+}
+
+func controlSocketPath() (string, error) {
+	dir, err := runtimeDir()
+	if err != nil {
+		return ``, err
+	}
+
+	return filepath.Join(dir, controlSocketName), nil
+}
+
 func findClient() (string, error) {
 	exe, err := os.Executable()
 	if err != nil {
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/config"
+	"github.com/peterbourgon/ff/v4"
+)
+
+func newValidateCommand(parent *ff.FlagSet, configFile *string) *ff.Command {
+	fs := ff.NewFlagSet(`validate`).SetParent(parent)
+
+	return &ff.Command{
+		Name:      `validate`,
+		Usage:     name + ` validate [FLAGS]`,
+		ShortHelp: `check the configuration file for errors`,
+		LongHelp:  `The configuration file, its includes and any overlay for this host are parsed and checked, and any problems are reported with their line and column. Monitor selectors are checked against the connected monitors when Hyprland is running. The same checks run before every reload, and configurations with errors are not applied. Exits non-zero if errors are found.`,
+		Flags:     fs,
+		Exec: func(_ context.Context, args []string) error {
+			if len(args) != 0 {
+				return errCtlUsage
+			}
+			return runValidate(*configFile)
+		},
+	}
+}
+
+func runValidate(configFile string) error {
+	src, err := os.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+
+	diags := config.Check(configFile, src, connectedMonitors(hclog.NewNullLogger())).Diagnostics
+	for _, diag := range diags {
+		fmt.Println(diag)
+	}
+
+	errs, warnings := len(diags.Errors()), len(diags.Warnings())
+	if errs > 0 {
+		return fmt.Errorf("%s: %d errors, %d warnings", configFile, errs, warnings)
+	}
+	fmt.Printf("%s: ok, %d warnings\n", configFile, warnings)
+
+	return nil
+}
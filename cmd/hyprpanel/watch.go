@@ -0,0 +1,65 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/hashicorp/go-hclog"
+)
+
+// configWatch tracks the files a configuration or stylesheet was read from,
+// including its includes, overlays and imports, and keeps their directories
+// watched.
+type configWatch struct {
+	mu      sync.Mutex
+	watcher *fsnotify.Watcher
+	files   map[string]struct{}
+	dirs    map[string]struct{}
+	log     hclog.Logger
+}
+
+// set replaces the tracked files, watching any new directories.
+func (w *configWatch) set(files []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.files = make(map[string]struct{}, len(files))
+	for _, file := range files {
+		file = filepath.Clean(file)
+		w.files[file] = struct{}{}
+		dir := filepath.Dir(file)
+		if _, ok := w.dirs[dir]; ok {
+			continue
+		}
+		if err := w.watcher.Add(dir); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				w.log.Debug(`Watched directory does not exist, not watching`, `path`, dir)
+				continue
+			}
+			w.log.Warn(`Failed adding filesystem watch path`, `path`, dir, `err`, err)
+			continue
+		}
+		w.dirs[dir] = struct{}{}
+	}
+}
+
+// has returns true if name is a tracked file.
+func (w *configWatch) has(name string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, ok := w.files[filepath.Clean(name)]
+
+	return ok
+}
+
+func newConfigWatch(watcher *fsnotify.Watcher, log hclog.Logger) *configWatch {
+	return &configWatch{
+		watcher: watcher,
+		files:   make(map[string]struct{}),
+		dirs:    make(map[string]struct{}),
+		log:     log,
+	}
+}
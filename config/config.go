@@ -23,8 +23,11 @@ func Default() (*configv1.Config, error) {
 	return c, nil
 }
 
-// Load and parse a configuration file from disk.
-func Load(filePath string) (*configv1.Config, error) {
+// Load, parse and validate a configuration file and its includes from disk,
+// checking monitor selectors against monitors when non-nil. Configurations
+// with errors are rejected, returning Diagnostics as the error, otherwise any
+// warnings are returned with the configuration.
+func Load(filePath string, monitors []string) (*Result, error) {
 	f, err := os.Open(filePath)
 	if err != nil {
 		return nil, err
@@ -40,11 +43,10 @@ func Load(filePath string) (*configv1.Config, error) {
 		return nil, err
 	}
 
-	c := &configv1.Config{}
-
-	if err := protojson.Unmarshal(b, c); err != nil {
-		return nil, err
+	result := Check(filePath, b, monitors)
+	if errs := result.Diagnostics.Errors(); len(errs) > 0 {
+		return result, errs
 	}
 
-	return c, nil
+	return result, nil
 }
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity of a Diagnostic.
+type Severity int
+
+const (
+	// SeverityError marks a configuration that cannot be used.
+	SeverityError Severity = iota
+	// SeverityWarning marks a configuration that is usable, but likely does
+	// not behave as intended.
+	SeverityWarning
+)
+
+// String implementation.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return `error`
+	case SeverityWarning:
+		return `warning`
+	default:
+		return `unknown`
+	}
+}
+
+// Diagnostic describes a problem found in a configuration file.
+type Diagnostic struct {
+	Severity Severity
+	File     string
+	Line     int    // 1-based line, or zero if unknown.
+	Column   int    // 1-based column, or zero if unknown.
+	Path     string // field path using proto names, e.g. `panels[0].edge`.
+	Message  string
+}
+
+// String formats the diagnostic as `file:line:column: severity: path: message`,
+// omitting unknown components.
+func (d Diagnostic) String() string {
+	var sb strings.Builder
+	if d.File != `` {
+		sb.WriteString(d.File)
+		if d.Line > 0 {
+			fmt.Fprintf(&sb, ":%d", d.Line)
+			if d.Column > 0 {
+				fmt.Fprintf(&sb, ":%d", d.Column)
+			}
+		}
+		sb.WriteString(`: `)
+	}
+	sb.WriteString(d.Severity.String())
+	sb.WriteString(`: `)
+	if d.Path != `` {
+		sb.WriteString(d.Path)
+		sb.WriteString(`: `)
+	}
+	sb.WriteString(d.Message)
+
+	return sb.String()
+}
+
+// Diagnostics is a list of problems found in a configuration file. It
+// satisfies error, and is returned as one when loading fails.
+type Diagnostics []Diagnostic
+
+// Error implementation, lists all errors.
+func (d Diagnostics) Error() string {
+	lines := make([]string, 0, len(d))
+	for _, diag := range d.Errors() {
+		lines = append(lines, diag.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Errors returns only the diagnostics with SeverityError.
+func (d Diagnostics) Errors() Diagnostics {
+	return d.filter(SeverityError)
+}
+
+// Warnings returns only the diagnostics with SeverityWarning.
+func (d Diagnostics) Warnings() Diagnostics {
+	return d.filter(SeverityWarning)
+}
+
+func (d Diagnostics) filter(severity Severity) Diagnostics {
+	var result Diagnostics
+	for _, diag := range d {
+		if diag.Severity == severity {
+			result = append(result, diag)
+		}
+	}
+
+	return result
+}
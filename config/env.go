@@ -0,0 +1,84 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// envReference matches ${NAME} and ${NAME:-default}, with a leading $ escaping
+// the reference.
+var envReference = regexp.MustCompile(`\$(\$?)\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// expand replaces environment variable references in s. Defaults apply when
+// the variable is unset or empty, unset variables without a default are
+// replaced with an empty string and reported.
+func (c *checker) expand(path, s string) string {
+	return envReference.ReplaceAllStringFunc(s, func(ref string) string {
+		match := envReference.FindStringSubmatch(ref)
+		if match[1] != `` {
+			return ref[1:]
+		}
+		value, ok := os.LookupEnv(match[2])
+		switch {
+		case match[3] != `` && value == ``:
+			return match[4]
+		case !ok:
+			c.warnf(path, "environment variable %s is not set", match[2])
+		}
+		return value
+	})
+}
+
+// interpolate expands environment variable references in all string fields of
+// msg.
+func (c *checker) interpolate(path string, msg protoreflect.Message) {
+	type update struct {
+		field protoreflect.FieldDescriptor
+		value protoreflect.Value
+	}
+	var updates []update
+
+	msg.Range(func(field protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		p := string(field.Name())
+		if path != `` {
+			p = path + `.` + p
+		}
+		switch {
+		case field.IsList():
+			list := v.List()
+			for i := range list.Len() {
+				ep := fmt.Sprintf("%s[%d]", p, i)
+				switch {
+				case field.Kind() == protoreflect.StringKind:
+					list.Set(i, protoreflect.ValueOfString(c.expand(ep, list.Get(i).String())))
+				case field.Message() != nil:
+					c.interpolate(ep, list.Get(i).Message())
+				}
+			}
+		case field.IsMap():
+			m := v.Map()
+			m.Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+				ep := fmt.Sprintf("%s[%s]", p, k.String())
+				switch {
+				case field.MapValue().Kind() == protoreflect.StringKind:
+					m.Set(k, protoreflect.ValueOfString(c.expand(ep, mv.String())))
+				case field.MapValue().Message() != nil:
+					c.interpolate(ep, mv.Message())
+				}
+				return true
+			})
+		case field.Kind() == protoreflect.StringKind:
+			updates = append(updates, update{field, protoreflect.ValueOfString(c.expand(p, v.String()))})
+		case field.Message() != nil:
+			c.interpolate(p, v.Message())
+		}
+		return true
+	})
+
+	for _, u := range updates {
+		msg.Set(u.field, u.value)
+	}
+}
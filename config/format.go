@@ -0,0 +1,308 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Format of a configuration file.
+type Format int
+
+const (
+	// FormatJSON is strict JSON, the canonical protojson encoding.
+	FormatJSON Format = iota
+	// FormatJSONC is JSON with comments and trailing commas.
+	FormatJSONC
+	// FormatYAML is YAML.
+	FormatYAML
+	// FormatTOML is TOML.
+	FormatTOML
+)
+
+const (
+	// baseName is the name of configuration files, without extension.
+	baseName = `config`
+)
+
+// extensions lists recognised file extensions, in order of preference.
+var extensions = []struct {
+	ext    string
+	format Format
+}{
+	{`.json`, FormatJSON},
+	{`.jsonc`, FormatJSONC},
+	{`.yaml`, FormatYAML},
+	{`.yml`, FormatYAML},
+	{`.toml`, FormatTOML},
+}
+
+var yamlErrorLine = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// String implementation.
+func (f Format) String() string {
+	switch f {
+	case FormatJSON:
+		return `json`
+	case FormatJSONC:
+		return `jsonc`
+	case FormatYAML:
+		return `yaml`
+	case FormatTOML:
+		return `toml`
+	default:
+		return `unknown`
+	}
+}
+
+// hasComments reports whether the format supports comments, which would be
+// lost by rewriting a file.
+func (f Format) hasComments() bool {
+	return f == FormatJSONC || f == FormatYAML || f == FormatTOML
+}
+
+// FormatOf returns the format of filePath, detected by extension. Unrecognised
+// extensions are treated as JSON.
+func FormatOf(filePath string) Format {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	for _, e := range extensions {
+		if e.ext == ext {
+			return e.format
+		}
+	}
+
+	return FormatJSON
+}
+
+// Candidates returns the recognised configuration file paths in dir, in order
+// of preference.
+func Candidates(dir string) []string {
+	return candidates(dir, baseName)
+}
+
+// Find returns the first configuration file in dir that exists, or the JSON
+// path if none do.
+func Find(dir string) string {
+	path, _ := find(dir, baseName)
+	return path
+}
+
+func candidates(dir, base string) []string {
+	paths := make([]string, len(extensions))
+	for i, e := range extensions {
+		paths[i] = filepath.Join(dir, base+e.ext)
+	}
+
+	return paths
+}
+
+// find returns the first file named base in dir with a recognised extension,
+// or the JSON path and false if none exist.
+func find(dir, base string) (string, bool) {
+	paths := candidates(dir, base)
+	for _, path := range paths {
+		if _, err := os.Stat(path); err == nil {
+			return path, true
+		}
+	}
+
+	return paths[0], false
+}
+
+// syntaxError is a parse error located in the source.
+type syntaxError struct {
+	pos position
+	msg string
+}
+
+// Error implementation.
+func (e *syntaxError) Error() string {
+	return `syntax error: ` + e.msg
+}
+
+// translate converts src in format to JSON for decoding with protojson, and
+// indexes field positions in src.
+func translate(format Format, src []byte) ([]byte, *sourceIndex, error) {
+	switch format {
+	case FormatJSONC:
+		b, err := stripJSONC(src)
+		if err != nil {
+			return nil, newSourceIndex(src), err
+		}
+		// Comments and trailing commas are blanked, preserving positions.
+		index, err := newJSONIndex(b)
+		return b, index, err
+	case FormatYAML:
+		return translateYAML(src)
+	case FormatTOML:
+		return translateTOML(src)
+	default:
+		index, err := newJSONIndex(src)
+		return src, index, err
+	}
+}
+
+// stripJSONC replaces comments and trailing commas in src with whitespace,
+// retaining newlines so that positions are unchanged.
+func stripJSONC(src []byte) ([]byte, error) {
+	b := make([]byte, len(src))
+	copy(b, src)
+	index := newSourceIndex(src)
+
+	blank := func(from, to int) {
+		for i := from; i < to; i++ {
+			if b[i] != '\n' && b[i] != '\r' {
+				b[i] = ' '
+			}
+		}
+	}
+
+	// lastComma is the offset of a comma that is trailing if the next token
+	// closes an object or array.
+	lastComma := -1
+	for i := 0; i < len(b); i++ {
+		switch c := b[i]; {
+		case c == '"':
+			lastComma = -1
+			for i++; i < len(b) && b[i] != '"'; i++ {
+				if b[i] == '\\' {
+					i++
+				}
+			}
+		case c == '/' && i+1 < len(b) && b[i+1] == '/':
+			end := i
+			for end < len(b) && b[end] != '\n' {
+				end++
+			}
+			blank(i, end)
+			i = end - 1
+		case c == '/' && i+1 < len(b) && b[i+1] == '*':
+			end := strings.Index(string(b[i+2:]), `*/`)
+			if end == -1 {
+				return nil, &syntaxError{pos: index.position(i), msg: `unterminated block comment`}
+			}
+			end += i + 4
+			blank(i, end)
+			i = end - 1
+		case c == ',':
+			lastComma = i
+		case c == '}' || c == ']':
+			if lastComma != -1 {
+				b[lastComma] = ' '
+			}
+			lastComma = -1
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		default:
+			lastComma = -1
+		}
+	}
+
+	return b, nil
+}
+
+func translateYAML(src []byte) ([]byte, *sourceIndex, error) {
+	index := newSourceIndex(src)
+	var doc yaml.Node
+	if err := yaml.Unmarshal(src, &doc); err != nil {
+		if match := yamlErrorLine.FindStringSubmatch(err.Error()); match != nil {
+			line, _ := strconv.Atoi(match[1])
+			return nil, index, &syntaxError{pos: position{line: line}, msg: match[2]}
+		}
+		return nil, index, &syntaxError{msg: strings.TrimPrefix(err.Error(), `yaml: `)}
+	}
+
+	v, err := yamlValue(&doc, ``, index)
+	if err != nil {
+		return nil, index, err
+	}
+	if v == nil {
+		v = map[string]any{}
+	}
+	b, err := json.Marshal(v)
+
+	return b, index, err
+}
+
+// yamlValue converts node to a value encodable as JSON, indexing positions.
+func yamlValue(node *yaml.Node, path string, index *sourceIndex) (any, error) {
+	pos := position{line: node.Line, column: node.Column}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return yamlValue(node.Content[0], path, index)
+	case yaml.AliasNode:
+		return yamlValue(node.Alias, path, index)
+	case yaml.MappingNode:
+		index.add(path, pos)
+		m := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Kind != yaml.ScalarNode {
+				return nil, &syntaxError{pos: position{line: key.Line, column: key.Column}, msg: `mapping keys must be strings`}
+			}
+			child := key.Value
+			if path != `` {
+				child = path + `.` + child
+			}
+			index.add(child, position{line: key.Line, column: key.Column})
+			v, err := yamlValue(value, child, index)
+			if err != nil {
+				return nil, err
+			}
+			m[key.Value] = v
+		}
+		return m, nil
+	case yaml.SequenceNode:
+		index.add(path, pos)
+		s := make([]any, len(node.Content))
+		for i, item := range node.Content {
+			v, err := yamlValue(item, path+`[`+strconv.Itoa(i)+`]`, index)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = v
+		}
+		return s, nil
+	case yaml.ScalarNode:
+		index.add(path, pos)
+		var v any
+		if err := node.Decode(&v); err != nil {
+			return nil, &syntaxError{pos: pos, msg: err.Error()}
+		}
+		if t, ok := v.(time.Time); ok {
+			return t.Format(time.RFC3339Nano), nil
+		}
+		return v, nil
+	default:
+		return nil, &syntaxError{pos: pos, msg: fmt.Sprintf("unsupported YAML node kind %d", node.Kind)}
+	}
+}
+
+func translateTOML(src []byte) ([]byte, *sourceIndex, error) {
+	// TOML decoding does not expose positions, so fields are not indexed and
+	// only syntax errors are located.
+	index := newSourceIndex(src)
+	v := make(map[string]any)
+	if err := toml.Unmarshal(src, &v); err != nil {
+		var decodeErr *toml.DecodeError
+		if errors.As(err, &decodeErr) {
+			line, column := decodeErr.Position()
+			return nil, index, &syntaxError{pos: position{line: line, column: column}, msg: decodeErr.Error()}
+		}
+		return nil, index, &syntaxError{msg: err.Error()}
+	}
+	b, err := json.Marshal(v)
+
+	return b, index, err
+}
@@ -0,0 +1,190 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// overlayDir is the directory, relative to the configuration file, that
+	// holds per-host overlays named by hostname (e.g. hosts/laptop.yaml).
+	overlayDir = `hosts`
+)
+
+// loader reads a configuration file and the files it includes.
+type loader struct {
+	// files lists every file the configuration depends on.
+	files []string
+	// sources counts the files that were parsed.
+	sources int
+	// stack holds the files currently being loaded, to detect cycles.
+	stack  []string
+	result Diagnostics
+	// present holds the paths filled by migrations that any file sets.
+	present map[string]struct{}
+	// pending lists the files with outstanding migrations.
+	pending []*pendingMigration
+}
+
+// load parses file, whose contents are src, and merges it over its includes.
+// The source index of file is returned for locating diagnostics. Nil is
+// returned if file or any of its includes could not be parsed.
+func (l *loader) load(file string, src []byte) (*configv1.Config, *sourceIndex) {
+	file = filepath.Clean(file)
+	l.files = append(l.files, file)
+	l.sources++
+
+	// The first file loaded is the root, all others are includes or
+	// overlays.
+	c := &checker{file: file, root: l.sources == 1}
+	defer func() {
+		l.result = append(l.result, c.result...)
+	}()
+
+	cfg := c.parse(src)
+	if cfg == nil {
+		return nil, c.index
+	}
+	for _, path := range c.present {
+		l.present[path] = struct{}{}
+	}
+	if c.migration != nil {
+		l.pending = append(l.pending, c.migration)
+	}
+	c.interpolate(``, cfg.ProtoReflect())
+	for i, path := range cfg.Replace {
+		if _, err := repeatedField(cfg.ProtoReflect().Descriptor(), path); err != nil {
+			c.errorf(fmt.Sprintf("replace[%d]", i), "%s", err)
+		}
+	}
+
+	l.stack = append(l.stack, file)
+	defer func() {
+		l.stack = l.stack[:len(l.stack)-1]
+	}()
+
+	merged := &configv1.Config{}
+	failed := false
+	for i, include := range cfg.Include {
+		p := fmt.Sprintf("include[%d]", i)
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(filepath.Dir(file), include)
+		}
+		include = filepath.Clean(include)
+		if slices.Contains(l.stack, include) {
+			c.errorf(p, "include cycle: %s", strings.Join(append(slices.Clone(l.stack), include), ` -> `))
+			failed = true
+			continue
+		}
+		b, err := os.ReadFile(include)
+		if err != nil {
+			c.errorf(p, "failed reading include: %s", err)
+			failed = true
+			continue
+		}
+		base, _ := l.load(include, b)
+		if base == nil {
+			failed = true
+			continue
+		}
+		merge(merged, base)
+	}
+	if failed {
+		return nil, c.index
+	}
+	cfg.Include = nil
+	merge(merged, cfg)
+
+	return merged, c.index
+}
+
+// overlay merges the overlay for the current host, if present, over cfg.
+func (l *loader) overlay(file string, cfg *configv1.Config) *configv1.Config {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == `` {
+		return cfg
+	}
+
+	dir := filepath.Join(filepath.Dir(file), overlayDir)
+	overlayFile, ok := find(dir, hostname)
+	if !ok {
+		// Watch for the overlay being created.
+		l.files = append(l.files, candidates(dir, hostname)...)
+		return cfg
+	}
+	src, err := os.ReadFile(overlayFile)
+	if err != nil {
+		l.files = append(l.files, overlayFile)
+		l.result = append(l.result, Diagnostic{Severity: SeverityError, File: overlayFile, Message: err.Error()})
+		return nil
+	}
+	o, _ := l.load(overlayFile, src)
+	if o == nil {
+		return nil
+	}
+	merge(cfg, o)
+
+	return cfg
+}
+
+// merge src into dst with proto.Merge semantics, after clearing the repeated
+// fields that src replaces.
+func merge(dst, src *configv1.Config) {
+	for _, path := range src.Replace {
+		clearField(dst.ProtoReflect(), path)
+	}
+	proto.Merge(dst, src)
+}
+
+// repeatedField resolves a dot-separated path of field names, traversing
+// singular message fields, to a repeated or map field.
+func repeatedField(desc protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	names := strings.Split(path, `.`)
+	fields := make([]protoreflect.FieldDescriptor, 0, len(names))
+	for i, name := range names {
+		if desc == nil {
+			return nil, fmt.Errorf("invalid field path %q: %s is not a message", path, strings.Join(names[:i], `.`))
+		}
+		field := desc.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			field = desc.Fields().ByJSONName(name)
+		}
+		if field == nil {
+			return nil, fmt.Errorf("invalid field path %q: unknown field %q", path, name)
+		}
+		fields = append(fields, field)
+		if i < len(names)-1 && (field.IsList() || field.IsMap()) {
+			return nil, fmt.Errorf("invalid field path %q: %s is repeated, only the whole field may be replaced", path, name)
+		}
+		desc = field.Message()
+	}
+	last := fields[len(fields)-1]
+	if !last.IsList() && !last.IsMap() {
+		return nil, errors.New(`only repeated fields may be replaced, singular fields are always overridden`)
+	}
+
+	return fields, nil
+}
+
+// clearField clears the field at path in msg, ignoring invalid paths.
+func clearField(msg protoreflect.Message, path string) {
+	fields, err := repeatedField(msg.Descriptor(), path)
+	if err != nil {
+		return
+	}
+	for _, field := range fields[:len(fields)-1] {
+		if !msg.Has(field) {
+			return
+		}
+		msg = msg.Mutable(field).Message()
+	}
+	msg.Clear(fields[len(fields)-1])
+}
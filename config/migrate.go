@@ -0,0 +1,439 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// CurrentVersion is the configuration version written by this release.
+	CurrentVersion = 1
+
+	versionKey = `version`
+)
+
+// Migration describes a change applied to upgrade a configuration file.
+type Migration struct {
+	Version     uint32 // version the configuration was upgraded to.
+	Description string
+}
+
+// FileMigration reports the migrations of a single configuration file.
+type FileMigration struct {
+	File       string
+	Migrations []Migration
+	// Backup is the path that the original file was copied to before it was
+	// rewritten.
+	Backup string
+	// Manual is set if the file was not rewritten, because its format carries
+	// comments, and must be migrated by hand.
+	Manual bool
+}
+
+// pendingMigration records the migrations applied in memory to a file.
+type pendingMigration struct {
+	file    string
+	root    bool
+	version uint32
+	applied []Migration
+	// defaults lists the paths filled from defaults in the merged
+	// configuration, which are written to the root file.
+	defaults []string
+}
+
+// migration upgrades a configuration document, as decoded from JSON, to
+// version.
+type migration struct {
+	version     uint32
+	description string
+	apply       func(doc map[string]any)
+	// defaults lists paths that are filled from the default configuration
+	// when absent, if their parent is present.
+	defaults []string
+}
+
+// migrations in version order.
+var migrations = []migration{
+	{
+		version:     1,
+		description: `move log_subprocesses_to_journal to launch_wrapper, fill idle_inhibitor, media_player and HUD notification options from defaults`,
+		apply:       migrateJournal,
+		defaults: []string{
+			`dbus.idle_inhibitor`,
+			`dbus.media_player`,
+			`dbus.brightness.hud_notifications`,
+			`dbus.power.hud_notifications`,
+			`audio.hud_notifications`,
+		},
+	},
+}
+
+// migrateJournal replaces log_subprocesses_to_journal with the equivalent
+// launch_wrapper, unless one is already configured.
+func migrateJournal(doc map[string]any) {
+	key, ok := lookupKey(doc, `log_subprocesses_to_journal`)
+	if !ok {
+		return
+	}
+	enabled := doc[key] == true
+	delete(doc, key)
+	if _, ok := lookupKey(doc, `launch_wrapper`); enabled && !ok {
+		doc[`launch_wrapper`] = []any{`systemd-cat`}
+	}
+}
+
+// migrate applies the migrations newer than version to doc, returning those
+// applied. Defaults are not filled here, since they must only be applied where
+// absent from the merged configuration, see fillDefaults.
+func migrate(doc map[string]any, version uint32) []Migration {
+	var applied []Migration
+	for _, m := range migrations {
+		if m.version <= version {
+			continue
+		}
+		if m.apply != nil {
+			m.apply(doc)
+		}
+		applied = append(applied, Migration{Version: m.version, Description: m.description})
+	}
+	if len(applied) > 0 {
+		key, ok := lookupKey(doc, versionKey)
+		if !ok {
+			key = versionKey
+		}
+		doc[key] = json.Number(strconv.Itoa(CurrentVersion))
+	}
+
+	return applied
+}
+
+// defaultPaths returns the paths filled from defaults by migrations newer than
+// version.
+func defaultPaths(version uint32) []string {
+	var paths []string
+	for _, m := range migrations {
+		if m.version > version {
+			paths = append(paths, m.defaults...)
+		}
+	}
+
+	return paths
+}
+
+// presentPaths returns the paths filled from defaults by any migration, and
+// their parents, that are present in doc.
+func presentPaths(doc map[string]any) []string {
+	var present []string
+	for _, path := range defaultPaths(0) {
+		for ; path != ``; path, _, _ = cutLast(path, `.`) {
+			if hasPath(doc, path) && !slices.Contains(present, path) {
+				present = append(present, path)
+			}
+		}
+	}
+
+	return present
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+
+	return ``, s, false
+}
+
+// hasPath reports whether the dot-separated path of field names is present in
+// doc.
+func hasPath(doc map[string]any, path string) bool {
+	names := strings.Split(path, `.`)
+	for _, name := range names[:len(names)-1] {
+		key, ok := lookupKey(doc, name)
+		if !ok {
+			return false
+		}
+		if doc, ok = doc[key].(map[string]any); !ok {
+			return false
+		}
+	}
+	_, ok := lookupKey(doc, names[len(names)-1])
+
+	return ok
+}
+
+// fillDefaults sets each of paths in the merged configuration cfg from the
+// default configuration, if the path is absent from every source document and
+// its parent is present in at least one, as recorded in present. The paths
+// that were set are returned.
+func fillDefaults(cfg *configv1.Config, paths []string, present map[string]struct{}) ([]string, error) {
+	var (
+		defaults *configv1.Config
+		filled   []string
+	)
+	for _, path := range paths {
+		if _, ok := present[path]; ok {
+			continue
+		}
+		if parent, _, ok := cutLast(path, `.`); ok {
+			if _, ok := present[parent]; !ok {
+				continue
+			}
+		}
+		if defaults == nil {
+			var err error
+			if defaults, err = Default(); err != nil {
+				return nil, err
+			}
+		}
+		copyField(cfg.ProtoReflect(), defaults.ProtoReflect(), path)
+		filled = append(filled, path)
+	}
+
+	return filled, nil
+}
+
+// copyField copies the field at the dot-separated path of proto field names
+// from src to dst, which must be of the same type.
+func copyField(dst, src protoreflect.Message, path string) {
+	names := strings.Split(path, `.`)
+	for _, name := range names[:len(names)-1] {
+		field := dst.Descriptor().Fields().ByName(protoreflect.Name(name))
+		dst, src = dst.Mutable(field).Message(), src.Get(field).Message()
+	}
+	field := dst.Descriptor().Fields().ByName(protoreflect.Name(names[len(names)-1]))
+	v := src.Get(field)
+	if field.Message() != nil {
+		v = protoreflect.ValueOfMessage(proto.Clone(v.Message().Interface()).ProtoReflect())
+	}
+	dst.Set(field, v)
+}
+
+// fillDefault copies path from defaults into doc if it is absent, creating its
+// parents as required.
+func fillDefault(doc, defaults map[string]any, path string) {
+	names := strings.Split(path, `.`)
+	for _, name := range names[:len(names)-1] {
+		defaultKey, ok := lookupKey(defaults, name)
+		if !ok {
+			return
+		}
+		defaultChild, ok := defaults[defaultKey].(map[string]any)
+		if !ok {
+			return
+		}
+		docKey, ok := lookupKey(doc, name)
+		if !ok {
+			docKey = name
+			doc[docKey] = map[string]any{}
+		}
+		child, ok := doc[docKey].(map[string]any)
+		if !ok {
+			return
+		}
+		doc, defaults = child, defaultChild
+	}
+
+	name := names[len(names)-1]
+	if _, ok := lookupKey(doc, name); ok {
+		return
+	}
+	if key, ok := lookupKey(defaults, name); ok {
+		doc[name] = defaults[key]
+	}
+}
+
+// lookupKey returns the key in obj for the field name, which may be spelled
+// with its proto or JSON name.
+func lookupKey(obj map[string]any, name string) (string, bool) {
+	if _, ok := obj[name]; ok {
+		return name, true
+	}
+	normalized := normalizePath(name)
+	for key := range obj {
+		if normalizePath(key) == normalized {
+			return key, true
+		}
+	}
+
+	return ``, false
+}
+
+// documentVersion returns the configuration version of doc. A missing version
+// is taken as 0 for the root document, which predates versioning, and as
+// current for includes and overlays, which need not declare one.
+func documentVersion(doc map[string]any, root bool) (uint32, error) {
+	key, ok := lookupKey(doc, versionKey)
+	if !ok && root {
+		return 0, nil
+	}
+	if !ok {
+		return CurrentVersion, nil
+	}
+	var s string
+	switch v := doc[key].(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return 0, fmt.Errorf("invalid version %v", v)
+	}
+	version, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q", s)
+	}
+
+	return uint32(version), nil
+}
+
+// decodeDocument decodes a JSON object, retaining numbers verbatim.
+func decodeDocument(b []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var doc map[string]any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, errors.New(`configuration must be an object`)
+	}
+
+	return doc, nil
+}
+
+// Migrate upgrades the outdated files of the configuration at filePath, its
+// includes and host overlay, to CurrentVersion. Defaults for options added
+// since the version of filePath are written to it, unless any of the files
+// already set them.
+//
+// JSON files are rewritten, after copying the original to a backup. Formats
+// that carry comments are not rewritten, since the comments would be lost, and
+// are returned with Manual set so they may be migrated by hand.
+func Migrate(filePath string) ([]FileMigration, error) {
+	src, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	result := Check(filePath, src, nil)
+	if errs := result.Diagnostics.Errors(); len(errs) > 0 {
+		return nil, errs
+	}
+
+	var (
+		migrated []FileMigration
+		errs     []error
+	)
+	for _, pending := range result.pending {
+		m := FileMigration{File: pending.file, Migrations: pending.applied}
+		if FormatOf(pending.file).hasComments() {
+			m.Manual = true
+		} else if m.Backup, err = rewrite(pending); err != nil {
+			errs = append(errs, fmt.Errorf("failed migrating %s: %w", pending.file, err))
+			continue
+		}
+		migrated = append(migrated, m)
+	}
+
+	return migrated, errors.Join(errs...)
+}
+
+// rewrite applies the pending migration to its JSON file, returning the path
+// of the backup of the original.
+func rewrite(pending *pendingMigration) (string, error) {
+	info, err := os.Stat(pending.file)
+	if err != nil {
+		return ``, err
+	}
+	src, err := os.ReadFile(pending.file)
+	if err != nil {
+		return ``, err
+	}
+	doc, err := decodeDocument(src)
+	if err != nil {
+		return ``, err
+	}
+	version, err := documentVersion(doc, pending.root)
+	if err != nil {
+		return ``, err
+	}
+	migrate(doc, version)
+	if len(pending.defaults) > 0 {
+		defaults, err := decodeDocument(defaultConfig)
+		if err != nil {
+			return ``, err
+		}
+		for _, path := range pending.defaults {
+			fillDefault(doc, defaults, path)
+		}
+	}
+	schema, _ := doc[schemaKey].(string)
+	delete(doc, schemaKey)
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return ``, err
+	}
+	cfg := &configv1.Config{}
+	if err := protojson.Unmarshal(b, cfg); err != nil {
+		return ``, fmt.Errorf("failed decoding migrated configuration: %w", err)
+	}
+	out, err := encode(cfg, schema)
+	if err != nil {
+		return ``, fmt.Errorf("failed encoding migrated configuration: %w", err)
+	}
+
+	backup := fmt.Sprintf("%s.v%d.bak", pending.file, version)
+	if _, err := os.Stat(backup); err == nil {
+		backup = fmt.Sprintf("%s.v%d.%s.bak", pending.file, version, time.Now().Format(`20060102150405`))
+	}
+	if err := os.WriteFile(backup, src, info.Mode().Perm()); err != nil {
+		return ``, fmt.Errorf("failed writing backup: %w", err)
+	}
+	if err := os.WriteFile(pending.file, out, info.Mode().Perm()); err != nil {
+		return backup, err
+	}
+
+	return backup, nil
+}
+
+// encode cfg as JSON, using proto field names, with schema as the $schema key
+// if set.
+func encode(cfg *configv1.Config, schema string) ([]byte, error) {
+	marshal := protojson.MarshalOptions{
+		Multiline:     true,
+		Indent:        "\t",
+		UseProtoNames: true,
+	}
+	b, err := marshal.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if schema == `` {
+		return b, nil
+	}
+	// Prepended textually to retain the field order of protojson.
+	key, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	prefix := "{\n\t\"" + schemaKey + "\": " + string(key)
+	rest := bytes.TrimSpace(bytes.TrimPrefix(bytes.TrimSpace(b), []byte(`{`)))
+	if !bytes.Equal(rest, []byte(`}`)) {
+		prefix += `,`
+	}
+
+	return append([]byte(prefix+"\n\t"), rest...), nil
+}
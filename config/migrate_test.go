@@ -0,0 +1,127 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	file := filepath.Join(dir, name)
+	if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+func check(t *testing.T, file string) *Result {
+	t.Helper()
+	src, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := Check(file, src, nil)
+	if errs := result.Diagnostics.Errors(); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	return result
+}
+
+func versionWarnings(result *Result, file string) int {
+	n := 0
+	for _, diag := range result.Diagnostics.Warnings() {
+		if diag.File == file && diag.Path == versionKey {
+			n++
+		}
+	}
+
+	return n
+}
+
+func TestMigrateIncludeNotOverridden(t *testing.T) {
+	dir := t.TempDir()
+	base := writeFile(t, dir, `base.jsonc`, `{
+		// Versionless includes are current.
+		"dbus": {"enabled": true, "idle_inhibitor": {"enabled": false}},
+	}`)
+	file := writeFile(t, dir, `config.json`, `{"include": ["base.jsonc"], "dbus": {"enabled": true}}`)
+
+	result := check(t, file)
+	if result.Config.Dbus.IdleInhibitor.Enabled {
+		t.Error(`idle_inhibitor from include was overridden by migration defaults`)
+	}
+	if !result.Config.Dbus.MediaPlayer.GetEnabled() {
+		t.Error(`media_player absent from every file was not filled from defaults`)
+	}
+	if n := versionWarnings(result, base); n != 0 {
+		t.Errorf("got %d version warnings for versionless include, want 0", n)
+	}
+	if n := versionWarnings(result, file); n != 1 {
+		t.Errorf("got %d version warnings for versionless root, want 1", n)
+	}
+}
+
+func TestMigrateRewritesJSON(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, `base.json`, `{"dbus": {"enabled": true, "idle_inhibitor": {"enabled": false}}}`)
+	file := writeFile(t, dir, `config.json`, `{"include": ["base.json"], "log_subprocesses_to_journal": true}`)
+
+	migrated, err := Migrate(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrated) != 1 || migrated[0].File != file || migrated[0].Manual || migrated[0].Backup == `` {
+		t.Fatalf("Migrate() = %+v, want the root file rewritten with a backup", migrated)
+	}
+	if _, err := os.Stat(migrated[0].Backup); err != nil {
+		t.Fatal(err)
+	}
+
+	result := check(t, file)
+	if n := versionWarnings(result, file); n != 0 {
+		t.Errorf("got %d version warnings after migration, want 0", n)
+	}
+	cfg := result.Config
+	if cfg.Version != CurrentVersion {
+		t.Errorf("version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	if cfg.Dbus.IdleInhibitor.Enabled {
+		t.Error(`idle_inhibitor from include was overridden by the migrated root file`)
+	}
+	if !cfg.Dbus.MediaPlayer.GetEnabled() {
+		t.Error(`media_player default was not written to the root file`)
+	}
+	if len(cfg.LaunchWrapper) != 1 || cfg.LaunchWrapper[0] != `systemd-cat` {
+		t.Errorf("launch_wrapper = %v, want [systemd-cat]", cfg.LaunchWrapper)
+	}
+}
+
+func TestMigratePreservesComments(t *testing.T) {
+	dir := t.TempDir()
+	src := []byte("{\n\t// Keep me.\n\t\"dbus\": {\"enabled\": true},\n}\n")
+	file := writeFile(t, dir, `config.jsonc`, string(src))
+
+	migrated, err := Migrate(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrated) != 1 || !migrated[0].Manual || migrated[0].Backup != `` {
+		t.Fatalf("Migrate() = %+v, want a manual migration", migrated)
+	}
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, src) {
+		t.Errorf("file was rewritten:\n%s", b)
+	}
+
+	// Still migrated in memory.
+	if !check(t, file).Config.Dbus.IdleInhibitor.GetEnabled() {
+		t.Error(`idle_inhibitor default was not filled in memory`)
+	}
+}
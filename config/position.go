@@ -0,0 +1,173 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// position in a source document.
+type position struct {
+	line   int
+	column int
+}
+
+// sourceIndex maps field paths in a source document to positions, so that
+// diagnostics found on the decoded configuration can be located in the file.
+// Paths are normalized, so that proto and JSON field names are equivalent.
+type sourceIndex struct {
+	src   []byte
+	paths map[string]position
+	// display holds the path of each position as spelled in the source.
+	display map[position]string
+}
+
+// lookup returns the position of path, or of its nearest ancestor present in
+// the source if path is absent.
+func (x *sourceIndex) lookup(path string) (position, bool) {
+	path = normalizePath(path)
+	for {
+		if pos, ok := x.paths[path]; ok {
+			return pos, true
+		}
+		i := strings.LastIndexAny(path, `.[`)
+		if i <= 0 {
+			return position{}, false
+		}
+		path = path[:i]
+	}
+}
+
+// pathAt returns the path of the field whose key or element is at pos, as
+// spelled in the source.
+func (x *sourceIndex) pathAt(pos position) string {
+	return x.display[pos]
+}
+
+// add records the position of a key or value, paths are located by their key
+// where present.
+func (x *sourceIndex) add(path string, pos position) {
+	if _, ok := x.display[pos]; !ok {
+		x.display[pos] = path
+	}
+	key := normalizePath(path)
+	if _, ok := x.paths[key]; !ok {
+		x.paths[key] = pos
+	}
+}
+
+// position converts a byte offset to a position.
+func (x *sourceIndex) position(offset int) position {
+	offset = min(max(offset, 0), len(x.src))
+	before := x.src[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	lineStart := bytes.LastIndexByte(before, '\n') + 1
+
+	return position{line: line, column: utf8.RuneCount(before[lineStart:]) + 1}
+}
+
+// skip returns the offset of the next token at or after offset.
+func (x *sourceIndex) skip(offset int) int {
+	for offset < len(x.src) {
+		switch x.src[offset] {
+		case ' ', '\t', '\r', '\n', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+
+	return offset
+}
+
+func (x *sourceIndex) walk(dec *json.Decoder, path string) error {
+	start := x.skip(int(dec.InputOffset()))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	x.add(path, x.position(start))
+
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			keyStart := x.skip(int(dec.InputOffset()))
+			key, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			name, ok := key.(string)
+			if !ok {
+				return errors.New(`invalid object key`)
+			}
+			child := name
+			if path != `` {
+				child = path + `.` + child
+			}
+			x.add(child, x.position(keyStart))
+			if err := x.walk(dec, child); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+		return err
+	case json.Delim('['):
+		for i := 0; dec.More(); i++ {
+			if err := x.walk(dec, path+`[`+strconv.Itoa(i)+`]`); err != nil {
+				return err
+			}
+		}
+		_, err = dec.Token()
+		return err
+	}
+
+	return nil
+}
+
+// newJSONIndex indexes the JSON document in src, returning a syntax error
+// if src is not valid JSON.
+func newJSONIndex(src []byte) (*sourceIndex, error) {
+	x := newSourceIndex(src)
+	dec := json.NewDecoder(bytes.NewReader(src))
+	dec.UseNumber()
+	if err := x.walk(dec, ``); err != nil {
+		return x, x.syntaxError(err)
+	}
+	if dec.More() {
+		start := x.skip(int(dec.InputOffset()))
+		return x, &syntaxError{pos: x.position(start), msg: `unexpected data after top-level value`}
+	}
+
+	return x, nil
+}
+
+// syntaxError locates an error returned while walking the document.
+func (x *sourceIndex) syntaxError(err error) error {
+	var jsonErr *json.SyntaxError
+	switch {
+	case errors.As(err, &jsonErr):
+		return &syntaxError{pos: x.position(int(jsonErr.Offset) - 1), msg: jsonErr.Error()}
+	case errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+		return &syntaxError{pos: x.position(len(x.src)), msg: `unexpected end of file`}
+	default:
+		return &syntaxError{msg: err.Error()}
+	}
+}
+
+func newSourceIndex(src []byte) *sourceIndex {
+	return &sourceIndex{
+		src:     src,
+		paths:   make(map[string]position),
+		display: make(map[position]string),
+	}
+}
+
+// normalizePath folds field names so that proto names (icon_size) and JSON
+// names (iconSize) compare equal.
+func normalizePath(path string) string {
+	return strings.ToLower(strings.ReplaceAll(path, `_`, ``))
+}
@@ -0,0 +1,280 @@
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/fs"
+	"math"
+	"regexp"
+	"strings"
+
+	protosrc "github.com/pdf/hyprpanel/proto"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	schemaDialect = `https://json-schema.org/draft/2020-12/schema`
+	schemaKey     = `$schema`
+)
+
+var (
+	protoPackage   = regexp.MustCompile(`^\s*package\s+([\w.]+)\s*;`)
+	protoScope     = regexp.MustCompile(`^\s*(message|enum|oneof)\s+(\w+)\s*\{`)
+	protoField     = regexp.MustCompile(`^\s*(?:repeated\s+)?(?:map<[^>]+>|[\w.]+)\s+(\w+)\s*=\s*\d+[^;]*;\s*(?://\s*(.*))?$`)
+	protoEnumValue = regexp.MustCompile(`^\s*(\w+)\s*=\s*-?\d+[^;]*;\s*(?://\s*(.*))?$`)
+)
+
+// protoComments extracts trailing field and enum value comments from the
+// embedded proto sources, keyed by full name.
+func protoComments() (map[string]string, error) {
+	comments := make(map[string]string)
+	err := fs.WalkDir(protosrc.Sources, `.`, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		f, err := protosrc.Sources.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		type scope struct {
+			kind, name string
+		}
+		var (
+			pkg   string
+			stack []scope
+		)
+		// prefix returns the full name of the enclosing message, skipping
+		// oneofs, which do not form part of field names.
+		prefix := func() string {
+			names := []string{pkg}
+			for _, s := range stack {
+				if s.kind != `oneof` {
+					names = append(names, s.name)
+				}
+			}
+			return strings.Join(names, `.`)
+		}
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := scanner.Text()
+			code, _, _ := strings.Cut(line, `//`)
+			switch {
+			case protoPackage.MatchString(line):
+				pkg = protoPackage.FindStringSubmatch(line)[1]
+			case protoScope.MatchString(line):
+				m := protoScope.FindStringSubmatch(line)
+				stack = append(stack, scope{kind: m[1], name: m[2]})
+			case len(stack) > 0 && stack[len(stack)-1].kind == `enum` && protoEnumValue.MatchString(line):
+				m := protoEnumValue.FindStringSubmatch(line)
+				if m[2] != `` {
+					comments[prefix()+`.`+m[1]] = strings.TrimSpace(m[2])
+				}
+			case len(stack) > 0 && protoField.MatchString(line):
+				m := protoField.FindStringSubmatch(line)
+				if m[2] != `` {
+					comments[prefix()+`.`+m[1]] = strings.TrimSpace(m[2])
+				}
+			}
+			for range strings.Count(code, `}`) {
+				if len(stack) > 0 {
+					stack = stack[:len(stack)-1]
+				}
+			}
+		}
+
+		return scanner.Err()
+	})
+
+	return comments, err
+}
+
+// schemaBuilder generates JSON Schema definitions from proto descriptors.
+type schemaBuilder struct {
+	comments map[string]string
+	defs     map[string]any
+}
+
+func (s *schemaBuilder) ref(name protoreflect.FullName) map[string]any {
+	return map[string]any{`$ref`: `#/$defs/` + string(name)}
+}
+
+func (s *schemaBuilder) message(desc protoreflect.MessageDescriptor) map[string]any {
+	name := desc.FullName()
+	if _, ok := s.defs[string(name)]; ok {
+		return s.ref(name)
+	}
+
+	if name == (&durationpb.Duration{}).ProtoReflect().Descriptor().FullName() {
+		s.defs[string(name)] = map[string]any{
+			`type`:        `string`,
+			`pattern`:     `^-?[0-9]+(\.[0-9]{1,9})?s$`,
+			`description`: `duration in seconds, with up to nine fractional digits (e.g. "20s", "0.200s").`,
+		}
+		return s.ref(name)
+	}
+
+	def := map[string]any{
+		`type`:                 `object`,
+		`title`:                string(desc.Name()),
+		`additionalProperties`: false,
+	}
+	// Registered before fields are visited, for recursive messages.
+	s.defs[string(name)] = def
+
+	properties := make(map[string]any)
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		properties[string(field.Name())] = s.field(field)
+	}
+	def[`properties`] = properties
+
+	oneofs := desc.Oneofs()
+	for i := range oneofs.Len() {
+		oneof := oneofs.Get(i)
+		if oneof.IsSynthetic() {
+			continue
+		}
+		names := make([]string, oneof.Fields().Len())
+		for j := range oneof.Fields().Len() {
+			names[j] = string(oneof.Fields().Get(j).Name())
+		}
+		if oneof.Fields().Len() == fields.Len() {
+			def[`minProperties`] = 1
+			def[`maxProperties`] = 1
+			def[`description`] = `exactly one of: ` + strings.Join(names, `, `)
+			continue
+		}
+		dependent := make(map[string]any, len(names))
+		for _, name := range names {
+			var others []any
+			for _, other := range names {
+				if other != name {
+					others = append(others, map[string]any{`required`: []string{other}})
+				}
+			}
+			dependent[name] = map[string]any{`not`: map[string]any{`anyOf`: others}}
+		}
+		def[`dependentSchemas`] = dependent
+	}
+
+	return s.ref(name)
+}
+
+func (s *schemaBuilder) enum(desc protoreflect.EnumDescriptor) map[string]any {
+	name := desc.FullName()
+	if _, ok := s.defs[string(name)]; ok {
+		return s.ref(name)
+	}
+
+	values := desc.Values()
+	names := make([]string, values.Len())
+	var descriptions []string
+	for i := range values.Len() {
+		value := values.Get(i)
+		names[i] = string(value.Name())
+		if comment := s.comments[string(name)+`.`+string(value.Name())]; comment != `` {
+			descriptions = append(descriptions, names[i]+`: `+comment)
+		}
+	}
+	def := map[string]any{
+		`type`:  `string`,
+		`title`: string(desc.Name()),
+		`enum`:  names,
+	}
+	if len(descriptions) > 0 {
+		def[`description`] = strings.Join(descriptions, "\n")
+	}
+	s.defs[string(name)] = def
+
+	return s.ref(name)
+}
+
+func (s *schemaBuilder) field(field protoreflect.FieldDescriptor) map[string]any {
+	var schema map[string]any
+	switch {
+	case field.IsMap():
+		schema = map[string]any{
+			`type`:                 `object`,
+			`additionalProperties`: s.value(field.MapValue()),
+		}
+	case field.IsList():
+		schema = map[string]any{
+			`type`:  `array`,
+			`items`: s.value(field),
+		}
+	default:
+		schema = s.value(field)
+	}
+
+	if comment := s.comments[string(field.FullName())]; comment != `` {
+		schema[`description`] = comment
+	}
+	if opts, ok := field.Options().(*descriptorpb.FieldOptions); ok && opts.GetDeprecated() {
+		schema[`deprecated`] = true
+	}
+
+	return schema
+}
+
+// value returns the schema for a single value of field, following the
+// protojson encoding.
+func (s *schemaBuilder) value(field protoreflect.FieldDescriptor) map[string]any {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{`type`: `boolean`}
+	case protoreflect.StringKind:
+		return map[string]any{`type`: `string`}
+	case protoreflect.BytesKind:
+		return map[string]any{`type`: `string`, `contentEncoding`: `base64`}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{`type`: `integer`, `minimum`: math.MinInt32, `maximum`: math.MaxInt32}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{`type`: `integer`, `minimum`: 0, `maximum`: uint32(math.MaxUint32)}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// protojson encodes 64-bit integers as strings, and accepts either.
+		return map[string]any{`type`: []string{`integer`, `string`}, `pattern`: `^-?[0-9]+$`}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{`type`: []string{`integer`, `string`}, `minimum`: 0, `pattern`: `^[0-9]+$`}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]any{`type`: `number`}
+	case protoreflect.EnumKind:
+		return s.enum(field.Enum())
+	default:
+		return s.message(field.Message())
+	}
+}
+
+// Schema returns a JSON Schema describing the configuration file format, for
+// validation and completion in editors. Field names use the proto spelling, as
+// in the default configuration.
+func Schema() ([]byte, error) {
+	comments, err := protoComments()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &schemaBuilder{comments: comments, defs: make(map[string]any)}
+	desc := (&configv1.Config{}).ProtoReflect().Descriptor()
+	root := s.message(desc)
+	def := s.defs[string(desc.FullName())].(map[string]any)
+	def[`properties`].(map[string]any)[schemaKey] = map[string]any{
+		`type`:        `string`,
+		`description`: `JSON Schema for this file, ignored by hyprpanel.`,
+	}
+
+	schema := map[string]any{
+		`$schema`: schemaDialect,
+		`title`:   `hyprpanel configuration`,
+		`$ref`:    root[`$ref`],
+		`$defs`:   s.defs,
+	}
+
+	return json.MarshalIndent(schema, ``, "\t")
+}
@@ -0,0 +1,586 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	monitorListSeparator = `,`
+)
+
+var (
+	// protojsonPosition matches the position in protojson errors, whose
+	// prefix is deliberately unstable.
+	protojsonPosition = regexp.MustCompile(`\(line (\d+):(\d+)\):\s*(.*)$`)
+	cssColorName      = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+	systrayModulePath = regexp.MustCompile(`\.systray\.modules\[\d+\]\.([^.\[]+)$`)
+
+	// zeroSizeAllowed lists size fields where zero is meaningful or the field
+	// is unused.
+	zeroSizeAllowed = map[string]struct{}{
+		`taskbar.max_size`:        {},
+		`systray.menu_icon_size`:  {},
+		`notifications.icon_size`: {},
+		`custom.icon_size`:        {},
+	}
+
+	// layoutProbe differs from the reference time in every component, so that
+	// formatting it with a layout changes every layout element.
+	layoutProbe = time.Date(1999, time.November, 28, 9, 37, 49, 123456789, time.UTC)
+)
+
+// checker accumulates diagnostics for a configuration file.
+type checker struct {
+	file  string
+	index *sourceIndex
+	// jsonIndex indexes the JSON decoded by protojson, which differs from the
+	// source for formats other than JSON.
+	jsonIndex *sourceIndex
+	result    Diagnostics
+
+	// root is set for the configuration file itself, rather than an include
+	// or overlay.
+	root bool
+	// present lists the paths filled by migrations that the file sets.
+	present []string
+	// migration records the migrations applied to the file in memory.
+	migration *pendingMigration
+}
+
+func (c *checker) add(severity Severity, path, format string, args ...any) {
+	diag := Diagnostic{
+		Severity: severity,
+		File:     c.file,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if c.index != nil {
+		if pos, ok := c.index.lookup(path); ok {
+			diag.Line, diag.Column = pos.line, pos.column
+		}
+	}
+	c.result = append(c.result, diag)
+}
+
+func (c *checker) errorf(path, format string, args ...any) {
+	c.add(SeverityError, path, format, args...)
+}
+
+func (c *checker) warnf(path, format string, args ...any) {
+	c.add(SeverityWarning, path, format, args...)
+}
+
+// syntax records a syntax error.
+func (c *checker) syntax(err error) {
+	diag := Diagnostic{Severity: SeverityError, File: c.file, Message: err.Error()}
+	var syntaxErr *syntaxError
+	if errors.As(err, &syntaxErr) {
+		diag.Line, diag.Column = syntaxErr.pos.line, syntaxErr.pos.column
+	}
+	c.result = append(c.result, diag)
+}
+
+// decode records an error returned by protojson, locating it in the source.
+func (c *checker) decode(err error) {
+	diag := Diagnostic{Severity: SeverityError, File: c.file, Message: err.Error()}
+	match := protojsonPosition.FindStringSubmatch(err.Error())
+	if match != nil {
+		pos := position{}
+		_, _ = fmt.Sscan(match[1], &pos.line)
+		_, _ = fmt.Sscan(match[2], &pos.column)
+		diag.Message = match[3]
+		if c.jsonIndex != nil {
+			diag.Path = c.jsonIndex.pathAt(pos)
+		}
+		if c.jsonIndex != c.index {
+			// Translated formats are located by path in the source.
+			pos = position{}
+			if c.index != nil && diag.Path != `` {
+				pos, _ = c.index.lookup(diag.Path)
+			}
+		}
+		diag.Line, diag.Column = pos.line, pos.column
+		if m := systrayModulePath.FindStringSubmatch(diag.Path); m != nil {
+			diag.Message = fmt.Sprintf("%s is not embeddable in systray, supported modules: %s", m[1], strings.Join(systrayModuleNames(), `, `))
+		}
+	}
+	c.result = append(c.result, diag)
+}
+
+func (c *checker) validate(cfg *configv1.Config, monitors []string) {
+	c.validatePanels(cfg, `panels`, cfg.Panels, monitors, nil)
+
+	names := make(map[string]int, len(cfg.Profiles))
+	for i, profile := range cfg.Profiles {
+		p := fmt.Sprintf("profiles[%d]", i)
+		if profile.Name == `` {
+			c.errorf(p+`.name`, `profile name must be set`)
+		} else if prev, ok := names[profile.Name]; ok {
+			c.errorf(p+`.name`, "duplicate profile name %q, also used by profiles[%d]", profile.Name, prev)
+		} else {
+			names[profile.Name] = i
+		}
+		if len(profile.Monitors) == 0 && !profile.Exact && i < len(cfg.Profiles)-1 {
+			c.warnf(p+`.monitors`, `profile without monitor rules always matches, later profiles will never be selected`)
+		}
+		if len(profile.Panels) == 0 {
+			c.warnf(p+`.panels`, `profile has no panels, nothing will be displayed while it is selected`)
+		}
+
+		ids := make(map[string]struct{}, len(profile.Monitors))
+		for j, rule := range profile.Monitors {
+			rp := fmt.Sprintf("%s.monitors[%d]", p, j)
+			if rule.Id != `` {
+				if _, ok := ids[rule.Id]; ok {
+					c.errorf(rp+`.id`, "duplicate monitor rule id %q", rule.Id)
+				}
+				if strings.ContainsAny(rule.Id, monitorListSeparator+`*?[`) {
+					c.errorf(rp+`.id`, "monitor rule id %q must not contain glob characters or %q", rule.Id, monitorListSeparator)
+				}
+				ids[rule.Id] = struct{}{}
+			}
+			for _, f := range []struct {
+				name, pattern string
+			}{
+				{`name`, rule.Name},
+				{`make`, rule.Make},
+				{`model`, rule.Model},
+				{`serial`, rule.Serial},
+			} {
+				if _, err := path.Match(f.pattern, ``); err != nil {
+					c.errorf(rp+`.`+f.name, "invalid pattern %q: %s", f.pattern, err)
+				}
+			}
+		}
+		// Profiles apply to other monitor topologies, so selectors are not
+		// checked against the connected monitors.
+		c.validatePanels(cfg, p+`.panels`, profile.Panels, nil, ids)
+	}
+
+	if cfg.Theme != nil {
+		c.validateTheme(cfg, cfg.Theme)
+	}
+}
+
+// validateTheme checks that palettes define valid colours, and that the
+// palettes selected for each colour scheme exist.
+func (c *checker) validateTheme(cfg *configv1.Config, theme *configv1.Config_Theme) {
+	for _, name := range slices.Sorted(maps.Keys(theme.Palettes)) {
+		pp := `theme.palettes.` + name
+		for _, color := range slices.Sorted(maps.Keys(theme.Palettes[name].GetColors())) {
+			cp := pp + `.colors.` + color
+			if !cssColorName.MatchString(color) {
+				c.errorf(cp, "invalid color name %q, must start with a letter and contain only letters, digits, - or _", color)
+			}
+			value := strings.TrimSpace(theme.Palettes[name].Colors[color])
+			if value == `` || strings.ContainsAny(value, `;{}`) {
+				c.errorf(cp, "invalid color value %q", value)
+			}
+		}
+	}
+	for _, f := range []struct {
+		name, palette string
+	}{
+		{`palette`, theme.Palette},
+		{`dark`, theme.Dark},
+		{`light`, theme.Light},
+	} {
+		if f.palette == `` {
+			continue
+		}
+		if _, ok := theme.Palettes[f.palette]; !ok {
+			c.errorf(`theme.`+f.name, "unknown palette %q", f.palette)
+		}
+	}
+	if theme.Dark != `` || theme.Light != `` {
+		dbus := cfg.Dbus
+		if dbus == nil || !dbus.Enabled || !dbus.Appearance.GetEnabled() {
+			c.warnf(`theme`, `dark and light palettes require dbus.enabled and dbus.appearance.enabled, only palette will be applied`)
+		}
+	}
+}
+
+// validatePanels checks panels, at path prefix p. Monitor selectors may name
+// the monitor rule ids in ids.
+func (c *checker) validatePanels(cfg *configv1.Config, p string, panels []*configv1.Panel, monitors []string, ids map[string]struct{}) {
+	panelIDs := make(map[string]int, len(panels))
+	for i, panel := range panels {
+		pp := fmt.Sprintf("%s[%d]", p, i)
+		if prev, ok := panelIDs[panel.Id]; ok {
+			c.errorf(pp+`.id`, "duplicate panel id %q, also used by %s[%d]", panel.Id, p, prev)
+		} else {
+			panelIDs[panel.Id] = i
+		}
+		if panel.Edge == configv1.Edge_EDGE_UNSPECIFIED {
+			c.errorf(pp+`.edge`, "edge must be set, one of: %s", strings.Join(enumNames(configv1.Edge_EDGE_UNSPECIFIED.Descriptor()), `, `))
+		}
+		if panel.Size == 0 {
+			c.errorf(pp+`.size`, `size must be greater than zero`)
+		}
+		c.validateMonitor(pp+`.monitor`, panel.Monitor, monitors, ids)
+		for j, mod := range panel.Modules {
+			c.validateModule(cfg, fmt.Sprintf("%s.modules[%d]", pp, j), mod)
+		}
+	}
+}
+
+func (c *checker) validateMonitor(p, selector string, monitors []string, ids map[string]struct{}) {
+	if selector == `` {
+		return
+	}
+	matched := false
+	for _, pattern := range strings.Split(selector, monitorListSeparator) {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == `` {
+			continue
+		}
+		if _, ok := ids[pattern]; ok {
+			continue
+		}
+		if _, err := path.Match(pattern, ``); err != nil {
+			c.errorf(p, "invalid monitor pattern %q: %s", pattern, err)
+			return
+		}
+		for _, name := range monitors {
+			if ok, _ := path.Match(pattern, name); ok {
+				matched = true
+			}
+		}
+	}
+	if monitors != nil && !matched {
+		c.warnf(p, "no connected monitor matches %q, the panel will not be displayed, connected monitors: %s", selector, strings.Join(monitors, `, `))
+	}
+}
+
+func (c *checker) validateModule(cfg *configv1.Config, p string, mod *modulev1.Module) {
+	if mod.Visibility != nil {
+		c.validateVisibility(p+`.visibility`, mod.Visibility)
+	}
+	msg := mod.ProtoReflect()
+	field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName(`kind`))
+	if field == nil {
+		c.errorf(p, `module kind must be set, one of: %s`, strings.Join(oneofNames(msg.Descriptor()), `, `))
+		return
+	}
+	name := string(field.Name())
+	p += `.` + name
+	c.validateSizes(p, name, msg.Get(field).Message())
+
+	dbus := cfg.Dbus
+	dbusEnabled := dbus != nil && dbus.Enabled
+	switch kind := mod.Kind.(type) {
+	case *modulev1.Module_Clock:
+		for _, f := range []struct {
+			name, layout string
+		}{
+			{`time_format`, kind.Clock.TimeFormat},
+			{`date_format`, kind.Clock.DateFormat},
+			{`tooltip_time_format`, kind.Clock.TooltipTimeFormat},
+			{`tooltip_date_format`, kind.Clock.TooltipDateFormat},
+		} {
+			if err := validateTimeLayout(f.layout); err != nil {
+				c.errorf(p+`.`+f.name, `%s`, err)
+			}
+		}
+	case *modulev1.Module_Systray:
+		if !dbusEnabled || dbus.Systray == nil || !dbus.Systray.Enabled {
+			c.warnf(p, `systray module requires dbus.enabled and dbus.systray.enabled`)
+		}
+		for k, sub := range kind.Systray.Modules {
+			sp := fmt.Sprintf("%s.modules[%d]", p, k)
+			subMsg := sub.ProtoReflect()
+			subField := subMsg.WhichOneof(subMsg.Descriptor().Oneofs().ByName(`kind`))
+			if subField == nil {
+				c.errorf(sp, `systray module kind must be set, supported modules: %s`, strings.Join(systrayModuleNames(), `, `))
+				continue
+			}
+			subName := string(subField.Name())
+			c.validateSizes(sp+`.`+subName, subName, subMsg.Get(subField).Message())
+			switch sub.Kind.(type) {
+			case *modulev1.SystrayModule_Audio:
+				c.requireAudio(cfg, sp+`.`+subName)
+			case *modulev1.SystrayModule_Power:
+				c.requireDBUS(dbusEnabled && dbus.Power != nil && dbus.Power.Enabled, sp+`.`+subName, `power`)
+			}
+		}
+	case *modulev1.Module_Notifications:
+		c.requireDBUS(dbusEnabled && dbus.Notifications != nil && dbus.Notifications.Enabled, p, `notifications`)
+	case *modulev1.Module_Audio:
+		c.requireAudio(cfg, p)
+	case *modulev1.Module_Power:
+		c.requireDBUS(dbusEnabled && dbus.Power != nil && dbus.Power.Enabled, p, `power`)
+	case *modulev1.Module_IdleInhibitor:
+		c.requireDBUS(dbusEnabled && dbus.IdleInhibitor != nil && dbus.IdleInhibitor.Enabled, p, `idle_inhibitor`)
+	case *modulev1.Module_MediaPlayer:
+		c.requireDBUS(dbusEnabled && dbus.MediaPlayer != nil && dbus.MediaPlayer.Enabled, p, `media_player`)
+	case *modulev1.Module_Widget:
+		if (kind.Widget.Path == ``) == (kind.Widget.Socket == ``) {
+			c.errorf(p, `exactly one of path or socket must be set`)
+		}
+	case *modulev1.Module_Custom:
+		if strings.TrimSpace(kind.Custom.Command) == `` {
+			c.errorf(p+`.command`, `command must be set`)
+		}
+	}
+}
+
+func (c *checker) validateVisibility(p string, vis *modulev1.Visibility) {
+	if len(vis.Conditions) == 0 {
+		c.warnf(p+`.conditions`, `no conditions configured, module is always displayed`)
+	}
+	for i, cond := range vis.Conditions {
+		cp := fmt.Sprintf("%s.conditions[%d]", p, i)
+		if _, ok := eventv1.ParseEventKind(cond.Event); !ok {
+			c.errorf(cp+`.event`, "unknown event %q, one of: %s", cond.Event, strings.Join(eventKindNames(), `, `))
+		}
+		for _, field := range slices.Sorted(maps.Keys(cond.Where)) {
+			if _, err := regexp.Compile(cond.Where[field]); err != nil {
+				c.errorf(cp+`.where.`+field, "invalid regular expression: %s", err)
+			}
+		}
+		if _, err := regexp.Compile(cond.Matches); err != nil {
+			c.errorf(cp+`.matches`, "invalid regular expression: %s", err)
+		}
+	}
+}
+
+// eventKindNames returns the names of event kinds that may be used in
+// visibility conditions, without the EVENT_KIND_ prefix.
+func eventKindNames() []string {
+	values := eventv1.EventKind_EVENT_KIND_UNSPECIFIED.Descriptor().Values()
+	names := make([]string, 0, values.Len())
+	for i := range values.Len() {
+		value := values.Get(i)
+		if value.Number() == protoreflect.EnumNumber(eventv1.EventKind_EVENT_KIND_UNSPECIFIED) {
+			continue
+		}
+		names = append(names, strings.TrimPrefix(string(value.Name()), `EVENT_KIND_`))
+	}
+
+	return names
+}
+
+func (c *checker) requireDBUS(enabled bool, p, subsystem string) {
+	if !enabled {
+		c.warnf(p, "module requires dbus.enabled and dbus.%s.enabled, it will not receive updates", subsystem)
+	}
+}
+
+func (c *checker) requireAudio(cfg *configv1.Config, p string) {
+	if cfg.Audio == nil || !cfg.Audio.Enabled {
+		c.warnf(p, `module requires audio.enabled, it will not receive updates`)
+	}
+}
+
+// validateSizes flags zero values in the size fields of a module
+// configuration, which would render nothing.
+func (c *checker) validateSizes(p, name string, msg protoreflect.Message) {
+	fields := msg.Descriptor().Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		fieldName := string(field.Name())
+		if field.Kind() != protoreflect.Uint32Kind || !strings.HasSuffix(fieldName, `size`) {
+			continue
+		}
+		if _, ok := zeroSizeAllowed[name+`.`+fieldName]; ok {
+			continue
+		}
+		if msg.Get(field).Uint() != 0 {
+			continue
+		}
+		if spacer, ok := msg.Interface().(*modulev1.Spacer); ok && spacer.Expand {
+			continue
+		}
+		c.warnf(p+`.`+fieldName, "%s is zero, nothing will be displayed", fieldName)
+	}
+}
+
+// validateTimeLayout returns an error if layout is non-empty and contains no
+// Go time layout elements, as is the case for strftime-style formats.
+func validateTimeLayout(layout string) error {
+	if layout == `` {
+		return nil
+	}
+	if layoutProbe.Format(layout) == layout {
+		hint := ``
+		if strings.Contains(layout, `%`) {
+			hint = `, strftime directives are not supported`
+		}
+		return fmt.Errorf("invalid Go time layout %q contains no layout elements%s, use the reference time Mon Jan 2 15:04:05 2006 (e.g. \"15:04\")", layout, hint)
+	}
+
+	return nil
+}
+
+func enumNames(desc protoreflect.EnumDescriptor) []string {
+	values := desc.Values()
+	names := make([]string, 0, values.Len())
+	for i := range values.Len() {
+		if values.Get(i).Number() == 0 {
+			continue
+		}
+		names = append(names, string(values.Get(i).Name()))
+	}
+
+	return names
+}
+
+func oneofNames(desc protoreflect.MessageDescriptor) []string {
+	fields := desc.Oneofs().ByName(`kind`).Fields()
+	names := make([]string, 0, fields.Len())
+	for i := range fields.Len() {
+		names = append(names, string(fields.Get(i).Name()))
+	}
+
+	return names
+}
+
+func systrayModuleNames() []string {
+	return oneofNames((&modulev1.SystrayModule{}).ProtoReflect().Descriptor())
+}
+
+// parse decodes the configuration in src, or records diagnostics and returns
+// nil if it is invalid. The format of src is detected from the file extension.
+func (c *checker) parse(src []byte) *configv1.Config {
+	format := FormatOf(c.file)
+	b, index, err := translate(format, src)
+	c.index = index
+	if err != nil {
+		c.syntax(err)
+		return nil
+	}
+	c.jsonIndex = index
+	if format == FormatYAML || format == FormatTOML {
+		if c.jsonIndex, err = newJSONIndex(b); err != nil {
+			c.syntax(err)
+			return nil
+		}
+	}
+
+	b = c.normalize(b)
+
+	cfg := &configv1.Config{}
+	if err := protojson.Unmarshal(b, cfg); err != nil {
+		c.decode(err)
+		return nil
+	}
+
+	return cfg
+}
+
+// normalize removes the $schema key used by editors from the JSON document b,
+// and applies outstanding migrations in memory, the file itself is rewritten
+// by Migrate. Invalid documents are returned unchanged for protojson to
+// report.
+func (c *checker) normalize(b []byte) []byte {
+	doc, err := decodeDocument(b)
+	if err != nil {
+		return b
+	}
+	_, changed := doc[schemaKey]
+	delete(doc, schemaKey)
+
+	version, err := documentVersion(doc, c.root)
+	if err != nil {
+		return b
+	}
+	if version > CurrentVersion {
+		c.warnf(versionKey, "configuration version %d is newer than the supported version %d, upgrade hyprpanel", version, CurrentVersion)
+	}
+	c.present = presentPaths(doc)
+	if applied := migrate(doc, version); len(applied) > 0 {
+		c.migration = &pendingMigration{file: c.file, root: c.root, version: version, applied: applied}
+		if FormatOf(c.file).hasComments() {
+			c.warnf(versionKey, "configuration version %d is outdated and was migrated to version %d in memory, the file is not rewritten to preserve comments and must be migrated by hand", version, CurrentVersion)
+		} else {
+			c.warnf(versionKey, "configuration version %d is outdated and was migrated to version %d in memory, the file will be rewritten on the next start", version, CurrentVersion)
+		}
+		changed = true
+	}
+	if !changed {
+		return b
+	}
+
+	normalized, err := json.Marshal(doc)
+	if err != nil {
+		return b
+	}
+	index, err := newJSONIndex(normalized)
+	if err != nil {
+		return b
+	}
+	c.jsonIndex = index
+
+	return normalized
+}
+
+// Result of checking a configuration.
+type Result struct {
+	// Config is the merged configuration, or nil if it could not be parsed.
+	Config *configv1.Config
+	// Files lists the files the configuration was read from, including host
+	// overlay paths that do not exist yet, for watching.
+	Files       []string
+	Diagnostics Diagnostics
+
+	// pending lists the files with outstanding migrations, for Migrate.
+	pending []*pendingMigration
+}
+
+// Check parses the configuration in src, read from file, merges it over its
+// includes and under any overlay for the current host, and validates the
+// result. Monitor selectors are checked against monitors when non-nil. The
+// configuration is returned when it could be parsed, even if validation found
+// errors.
+func Check(file string, src []byte, monitors []string) *Result {
+	l := &loader{present: make(map[string]struct{})}
+	cfg, index := l.load(file, src)
+	if cfg != nil {
+		cfg = l.overlay(file, cfg)
+	}
+	result := &Result{Files: l.files}
+	if cfg == nil {
+		result.Diagnostics = l.result
+		return result
+	}
+	cfg.Include, cfg.Replace = nil, nil
+
+	c := &checker{file: filepath.Clean(file), result: l.result}
+	for _, pending := range l.pending {
+		if !pending.root {
+			continue
+		}
+		// Defaults are only filled in the merged configuration, so that they
+		// never override values from includes or overlays.
+		filled, err := fillDefaults(cfg, defaultPaths(pending.version), l.present)
+		if err != nil {
+			c.errorf(versionKey, "failed migrating configuration: %s", err)
+		}
+		pending.defaults = filled
+	}
+	result.pending = l.pending
+	if l.sources == 1 {
+		// Merged configurations do not correspond to a single source, so
+		// positions are only reported for standalone files.
+		c.index = index
+	}
+	c.validate(cfg, monitors)
+	result.Config, result.Diagnostics = cfg, c.result
+
+	return result
+}
@@ -0,0 +1,190 @@
+package dbus
+
+import (
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/hashicorp/go-hclog"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	settingsName                 = `org.freedesktop.portal.Settings`
+	settingsMethodReadOne        = settingsName + `.ReadOne`
+	settingsMethodRead           = settingsName + `.Read`
+	settingsMemberSettingChanged = `SettingChanged`
+	settingsSignalSettingChanged = settingsName + `.` + settingsMemberSettingChanged
+
+	appearanceNamespace      = `org.freedesktop.appearance`
+	appearanceKeyColorScheme = `color-scheme`
+)
+
+type appearance struct {
+	conn         *dbus.Conn
+	log          hclog.Logger
+	portalClient *portalClient
+	eventCh      chan *eventv1.Event
+	signals      chan *dbus.Signal
+	colorScheme  eventv1.ColorScheme
+	quitCh       chan struct{}
+}
+
+func (a *appearance) init() error {
+	var v dbus.Variant
+	if err := a.portalClient.busObj.Call(settingsMethodReadOne, 0, appearanceNamespace, appearanceKeyColorScheme).Store(&v); err != nil {
+		// ReadOne was added in version 2 of the interface, Read returns the
+		// value wrapped in an additional variant.
+		if err := a.portalClient.busObj.Call(settingsMethodRead, 0, appearanceNamespace, appearanceKeyColorScheme).Store(&v); err != nil {
+			a.log.Warn(`Failed reading color scheme from Settings portal, assuming no preference`, `err`, err)
+			return nil
+		}
+		if inner, ok := v.Value().(dbus.Variant); ok {
+			v = inner
+		}
+	}
+
+	colorScheme, err := parseColorScheme(v)
+	if err != nil {
+		a.log.Warn(`Failed parsing color scheme from Settings portal, assuming no preference`, `err`, err)
+		return nil
+	}
+	a.update(colorScheme)
+
+	return nil
+}
+
+func (a *appearance) update(colorScheme eventv1.ColorScheme) {
+	if colorScheme == a.colorScheme {
+		return
+	}
+	a.colorScheme = colorScheme
+	a.log.Debug(`Color scheme changed`, `colorScheme`, colorScheme)
+
+	data, err := anypb.New(&eventv1.ColorSchemeValue{ColorScheme: colorScheme})
+	if err != nil {
+		a.log.Warn(`Failed encoding color scheme event`, `err`, err)
+		return
+	}
+	a.eventCh <- &eventv1.Event{
+		Kind: eventv1.EventKind_EVENT_KIND_DBUS_COLOR_SCHEME_CHANGE,
+		Data: data,
+	}
+}
+
+func (a *appearance) processSettingChanged(sig *dbus.Signal) error {
+	if len(sig.Body) != 3 {
+		return fmt.Errorf("failed parsing SettingChanged body: %+v", sig.Body)
+	}
+	namespace, ok := sig.Body[0].(string)
+	if !ok {
+		return fmt.Errorf("failed asserting SettingChanged body: %+v", sig.Body)
+	}
+	key, ok := sig.Body[1].(string)
+	if !ok {
+		return fmt.Errorf("failed asserting SettingChanged body: %+v", sig.Body)
+	}
+	if namespace != appearanceNamespace || key != appearanceKeyColorScheme {
+		return nil
+	}
+	v, ok := sig.Body[2].(dbus.Variant)
+	if !ok {
+		return fmt.Errorf("failed asserting SettingChanged body: %+v", sig.Body)
+	}
+
+	colorScheme, err := parseColorScheme(v)
+	if err != nil {
+		return err
+	}
+	a.update(colorScheme)
+
+	return nil
+}
+
+func (a *appearance) watch() {
+	for {
+		select {
+		case <-a.quitCh:
+			return
+		default:
+			select {
+			case <-a.quitCh:
+				return
+			case sig, ok := <-a.signals:
+				if !ok {
+					return
+				}
+				switch sig.Name {
+				case settingsSignalSettingChanged:
+					if err := a.processSettingChanged(sig); err != nil {
+						a.log.Warn(`Failed processing Settings portal change`, `sig`, sig, `err`, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+func (a *appearance) close() error {
+	close(a.quitCh)
+	close(a.portalClient.quitCh)
+	a.conn.RemoveSignal(a.signals)
+	a.conn.RemoveSignal(a.portalClient.signals)
+
+	return a.conn.RemoveMatchSignal(
+		dbus.WithMatchInterface(settingsName),
+		dbus.WithMatchMember(settingsMemberSettingChanged),
+		dbus.WithMatchObjectPath(portalPath),
+	)
+}
+
+// parseColorScheme converts the org.freedesktop.appearance color-scheme value,
+// where 0 is no preference, 1 prefers dark and 2 prefers light.
+func parseColorScheme(v dbus.Variant) (eventv1.ColorScheme, error) {
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return eventv1.ColorScheme_COLOR_SCHEME_UNSPECIFIED, fmt.Errorf("unexpected color scheme type %s", v.Signature())
+	}
+	switch value {
+	case 1:
+		return eventv1.ColorScheme_COLOR_SCHEME_DARK, nil
+	case 2:
+		return eventv1.ColorScheme_COLOR_SCHEME_LIGHT, nil
+	default:
+		return eventv1.ColorScheme_COLOR_SCHEME_UNSPECIFIED, nil
+	}
+}
+
+func newAppearance(conn *dbus.Conn, logger hclog.Logger, eventCh chan *eventv1.Event) (*appearance, error) {
+	p, err := newPortalClient(conn, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &appearance{
+		conn:         conn,
+		log:          logger,
+		portalClient: p,
+		eventCh:      eventCh,
+		signals:      make(chan *dbus.Signal, 10),
+		quitCh:       make(chan struct{}),
+	}
+
+	if err := a.conn.AddMatchSignal(
+		dbus.WithMatchInterface(settingsName),
+		dbus.WithMatchMember(settingsMemberSettingChanged),
+		dbus.WithMatchObjectPath(portalPath),
+	); err != nil {
+		return nil, err
+	}
+
+	a.conn.Signal(a.signals)
+
+	if err := a.init(); err != nil {
+		return nil, err
+	}
+
+	go a.watch()
+
+	return a, nil
+}
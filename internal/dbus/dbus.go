@@ -75,6 +75,7 @@ type Client struct {
 	power           *power
 	idleInhibitor   *idleInhibitor
 	mediaPlayer     *mediaPlayer
+	appearance      *appearance
 }
 
 // Systray API.
@@ -129,6 +130,11 @@ func (c *Client) Close() error {
 			c.log.Warn(`Failed closing Notifications session`, `err`, err)
 		}
 	}
+	if c.appearance != nil {
+		if err := c.appearance.close(); err != nil {
+			c.log.Warn(`Failed closing Appearance session`, `err`, err)
+		}
+	}
 	if c.globalShortcuts != nil {
 		if err := c.globalShortcuts.close(); err != nil {
 			c.log.Warn(`Failed closing GlobalShortcuts session`, `err`, err)
@@ -205,6 +211,12 @@ func New(cfg *configv1.Config_DBUS, logger hclog.Logger) (*Client, <-chan *event
 		}
 	}
 
+	if cfg.Appearance.GetEnabled() {
+		if c.appearance, err = newAppearance(sessionConn, logger, c.eventCh); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	if err := c.init(); err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,388 @@
+package dbus_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/hashicorp/go-hclog"
+	hpdbus "github.com/pdf/hyprpanel/internal/dbus"
+	"github.com/pdf/hyprpanel/internal/dbus/dbustest"
+	configv1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const eventTimeout = 5 * time.Second
+
+// testConfig returns a configuration with every feature disabled, to be
+// enabled by fn.
+func testConfig(fn func(cfg *configv1.Config_DBUS)) *configv1.Config_DBUS {
+	cfg := &configv1.Config_DBUS{
+		Enabled:         true,
+		ConnectTimeout:  durationpb.New(eventTimeout),
+		ConnectInterval: durationpb.New(100 * time.Millisecond),
+		Notifications:   &configv1.Config_DBUS_Notifications{},
+		Systray:         &configv1.Config_DBUS_Systray{},
+		Shortcuts:       &configv1.Config_DBUS_Shortcuts{},
+		Brightness:      &configv1.Config_DBUS_Brightness{},
+		Power:           &configv1.Config_DBUS_Power{},
+		IdleInhibitor:   &configv1.Config_DBUS_IdleInhibitor{},
+		MediaPlayer:     &configv1.Config_DBUS_MediaPlayer{},
+		Appearance:      &configv1.Config_DBUS_Appearance{},
+	}
+	fn(cfg)
+
+	return cfg
+}
+
+func newHarness(t *testing.T) *dbustest.Harness {
+	t.Helper()
+	h, err := dbustest.New()
+	if errors.Is(err, dbustest.ErrDaemonNotFound) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := h.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return h
+}
+
+func newClient(t *testing.T, cfg *configv1.Config_DBUS) (*hpdbus.Client, <-chan *eventv1.Event) {
+	t.Helper()
+	c, ch, err := hpdbus.New(cfg, hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := c.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return c, ch
+}
+
+// waitEvent waits for an event of kind, and decodes its value into v.
+func waitEvent(t *testing.T, ch <-chan *eventv1.Event, kind eventv1.EventKind, v proto.Message) {
+	t.Helper()
+	evt, err := dbustest.WaitEvent(ch, kind, eventTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := evt.Data.UnmarshalTo(v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitCall waits for calls to return a call to method.
+func waitCall(t *testing.T, calls func() []dbustest.Call, method string) dbustest.Call {
+	t.Helper()
+	deadline := time.Now().Add(eventTimeout)
+	for time.Now().Before(deadline) {
+		for _, call := range calls() {
+			if call.Method == method {
+				return call
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s call", method)
+
+	return dbustest.Call{}
+}
+
+func closeService(t *testing.T, service interface{ Close() error }) {
+	t.Cleanup(func() {
+		_ = service.Close()
+	})
+}
+
+func TestNotifications(t *testing.T) {
+	h := newHarness(t)
+	c, ch := newClient(t, testConfig(func(cfg *configv1.Config_DBUS) {
+		cfg.Notifications = &configv1.Config_DBUS_Notifications{Enabled: true}
+	}))
+
+	conn, err := h.Session.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	if err := conn.AddMatchSignal(dbus.WithMatchInterface(`org.freedesktop.Notifications`), dbus.WithMatchMember(`NotificationClosed`)); err != nil {
+		t.Fatal(err)
+	}
+	signals := make(chan *dbus.Signal, 1)
+	conn.Signal(signals)
+
+	var id uint32
+	obj := conn.Object(`org.freedesktop.Notifications`, `/org/freedesktop/Notifications`)
+	if err := obj.Call(`org.freedesktop.Notifications.Notify`, 0,
+		`test`, uint32(0), `dialog-information`, `Summary`, `Body`,
+		[]string{`default`, `Open`},
+		map[string]dbus.Variant{`urgency`: dbus.MakeVariant(byte(2))},
+		int32(5000),
+	).Store(&id); err != nil {
+		t.Fatal(err)
+	}
+
+	v := &eventv1.NotificationValue{}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_DBUS_NOTIFICATION, v)
+	if v.Id != id || v.AppName != `test` || v.Summary != `Summary` || v.Body != `Body` || v.Timeout.AsDuration() != 5*time.Second {
+		t.Fatalf("got notification %+v, want id %d", v, id)
+	}
+	if len(v.Actions) != 1 || v.Actions[0].Key != `default` || v.Actions[0].Value != `Open` {
+		t.Fatalf("got actions %+v", v.Actions)
+	}
+
+	if err := c.Notification().Closed(id, hyprpanelv1.NotificationClosedReason_NOTIFICATION_CLOSED_REASON_DISMISSED); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case sig := <-signals:
+		if len(sig.Body) < 1 || sig.Body[0] != id {
+			t.Fatalf("got NotificationClosed %v, want id %d", sig.Body, id)
+		}
+	case <-time.After(eventTimeout):
+		t.Fatal(`timed out waiting for NotificationClosed`)
+	}
+}
+
+func TestPower(t *testing.T) {
+	h := newHarness(t)
+	upower, err := dbustest.NewUPower(h.System, map[string]any{
+		`Type`:       uint32(2),
+		`Percentage`: float64(80),
+		`State`:      uint32(2),
+		`IsPresent`:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeService(t, upower)
+
+	_, ch := newClient(t, testConfig(func(cfg *configv1.Config_DBUS) {
+		cfg.Power = &configv1.Config_DBUS_Power{Enabled: true, LowPercent: 10, CriticalPercent: 5}
+	}))
+
+	v := &eventv1.PowerChangeValue{}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE, v)
+	if v.Id != eventv1.PowerDefaultID || v.Percentage != 80 || v.State != eventv1.PowerState_POWER_STATE_DISCHARGING {
+		t.Fatalf("got initial power %+v", v)
+	}
+
+	if err := upower.SetDevice(dbustest.UPowerDisplayDevice, map[string]any{`Percentage`: float64(79)}); err != nil {
+		t.Fatal(err)
+	}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE, v)
+	if v.Percentage != 79 || v.State != eventv1.PowerState_POWER_STATE_DISCHARGING {
+		t.Fatalf("got updated power %+v", v)
+	}
+}
+
+func TestIdleInhibitor(t *testing.T) {
+	h := newHarness(t)
+	logind, err := dbustest.NewLogind(h.System)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeService(t, logind)
+
+	c, ch := newClient(t, testConfig(func(cfg *configv1.Config_DBUS) {
+		cfg.IdleInhibitor = &configv1.Config_DBUS_IdleInhibitor{Enabled: true}
+	}))
+
+	if err := c.IdleInhibitor().Inhibit(eventv1.InhibitTarget_INHIBIT_TARGET_IDLE); err != nil {
+		t.Fatal(err)
+	}
+	v := &eventv1.IdleInhibitorValue{}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_IDLE_INHIBITOR_INHIBIT, v)
+	if v.Target != eventv1.InhibitTarget_INHIBIT_TARGET_IDLE {
+		t.Fatalf("got inhibit target %s", v.Target)
+	}
+	if locks := logind.Inhibitors(); len(locks) != 1 || locks[0].What != `idle` {
+		t.Fatalf("got inhibitor locks %+v", locks)
+	}
+
+	if err := c.IdleInhibitor().Uninhibit(eventv1.InhibitTarget_INHIBIT_TARGET_IDLE); err != nil {
+		t.Fatal(err)
+	}
+	// Changes report the state of every target, so events for the other
+	// targets are skipped.
+	v.Reset()
+	for v.Target != eventv1.InhibitTarget_INHIBIT_TARGET_IDLE {
+		waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_IDLE_INHIBITOR_UNINHIBIT, v)
+	}
+	if locks := logind.Inhibitors(); len(locks) != 0 {
+		t.Fatalf("got inhibitor locks %+v after uninhibit", locks)
+	}
+}
+
+func TestMediaPlayer(t *testing.T) {
+	h := newHarness(t)
+	c, ch := newClient(t, testConfig(func(cfg *configv1.Config_DBUS) {
+		cfg.MediaPlayer = &configv1.Config_DBUS_MediaPlayer{Enabled: true}
+	}))
+
+	player, err := dbustest.NewMediaPlayer(h.Session, `test`, map[string]any{
+		`Identity`: `Test Player`,
+	}, map[string]any{
+		`PlaybackStatus`: dbustest.PlaybackPaused,
+		`CanGoNext`:      true,
+		`Metadata`: map[string]dbus.Variant{
+			`mpris:trackid`: dbus.MakeVariant(dbus.ObjectPath(`/track/1`)),
+			`xesam:title`:   dbus.MakeVariant(`Title`),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeService(t, player)
+
+	v := &eventv1.MediaPlayerValueChange{}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, v)
+	if v.GetIdentity() != `Test Player` {
+		t.Fatalf("got initial player %+v", v)
+	}
+
+	if err := player.Set(map[string]any{`PlaybackStatus`: dbustest.PlaybackPaused}); err != nil {
+		t.Fatal(err)
+	}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, v)
+	if v.State != eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_PAUSED {
+		t.Fatalf("got player state %s, want paused", v.State)
+	}
+
+	if err := c.MediaPlayer().PlayPause(); err != nil {
+		t.Fatal(err)
+	}
+	waitCall(t, player.Calls, `PlayPause`)
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, v)
+	if v.State != eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_PLAYING {
+		t.Fatalf("got player state %s after PlayPause", v.State)
+	}
+
+	if err := player.Close(); err != nil {
+		t.Fatal(err)
+	}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, v)
+	if v.State != eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_STOPPED || v.Identity != nil || v.TrackId != `` {
+		t.Fatalf("got player %+v after the last player exited", v)
+	}
+}
+
+func TestSystray(t *testing.T) {
+	h := newHarness(t)
+	c, ch := newClient(t, testConfig(func(cfg *configv1.Config_DBUS) {
+		cfg.Systray = &configv1.Config_DBUS_Systray{Enabled: true}
+	}))
+
+	item, err := dbustest.NewStatusNotifierItem(h.Session, map[string]any{
+		`Id`:       `test`,
+		`Title`:    `Test`,
+		`Status`:   `Active`,
+		`IconName`: `test-icon`,
+	}, dbustest.MenuItem{ID: 0, Children: []dbustest.MenuItem{
+		{ID: 1, Properties: map[string]any{`label`: `Quit`}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeService(t, item)
+
+	registered := &eventv1.StatusNotifierValue{}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_DBUS_REGISTERSTATUSNOTIFIER, registered)
+	if registered.BusName != item.BusName() || registered.Title != `Test` || registered.Icon.GetIconName() != `test-icon` {
+		t.Fatalf("got registration %+v", registered)
+	}
+
+	if err := item.SetTitle(`Updated`); err != nil {
+		t.Fatal(err)
+	}
+	title := &eventv1.UpdateTitleValue{}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_DBUS_UPDATETITLE, title)
+	if title.BusName != item.BusName() || title.Title != `Updated` {
+		t.Fatalf("got title update %+v", title)
+	}
+
+	if err := c.Systray().Activate(item.BusName(), 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	call := waitCall(t, item.Calls, `Activate`)
+	if !slices.Equal(call.Args, []any{int32(1), int32(2)}) {
+		t.Fatalf("got Activate args %v", call.Args)
+	}
+}
+
+func TestGlobalShortcuts(t *testing.T) {
+	h := newHarness(t)
+	portal, err := dbustest.NewGlobalShortcuts(h.Session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeService(t, portal)
+
+	_, ch := newClient(t, testConfig(func(cfg *configv1.Config_DBUS) {
+		cfg.Shortcuts = &configv1.Config_DBUS_Shortcuts{Enabled: true}
+	}))
+
+	const id = `com.c0dedbad.hyprpanel.audioSinkVolumeUp`
+	deadline := time.Now().Add(eventTimeout)
+	for !slices.ContainsFunc(portal.Shortcuts(), func(s dbustest.Shortcut) bool { return s.ID == id }) {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s to be bound", id)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := portal.Activate(id); err != nil {
+		t.Fatal(err)
+	}
+	v := &eventv1.AudioSinkVolumeAdjust{}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_AUDIO_SINK_VOLUME_ADJUST, v)
+	if v.Id != eventv1.AudioDefaultSink || v.Direction != eventv1.Direction_DIRECTION_UP {
+		t.Fatalf("got volume adjustment %+v", v)
+	}
+}
+
+func TestAppearance(t *testing.T) {
+	h := newHarness(t)
+	settings, err := dbustest.NewSettings(h.Session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	closeService(t, settings)
+	if err := settings.Set(`org.freedesktop.appearance`, `color-scheme`, uint32(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	_, ch := newClient(t, testConfig(func(cfg *configv1.Config_DBUS) {
+		cfg.Appearance = &configv1.Config_DBUS_Appearance{Enabled: true}
+	}))
+
+	v := &eventv1.ColorSchemeValue{}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_DBUS_COLOR_SCHEME_CHANGE, v)
+	if v.ColorScheme != eventv1.ColorScheme_COLOR_SCHEME_DARK {
+		t.Fatalf("got initial color scheme %s", v.ColorScheme)
+	}
+
+	if err := settings.Set(`org.freedesktop.appearance`, `color-scheme`, uint32(2)); err != nil {
+		t.Fatal(err)
+	}
+	waitEvent(t, ch, eventv1.EventKind_EVENT_KIND_DBUS_COLOR_SCHEME_CHANGE, v)
+	if v.ColorScheme != eventv1.ColorScheme_COLOR_SCHEME_LIGHT {
+		t.Fatalf("got updated color scheme %s", v.ColorScheme)
+	}
+}
@@ -0,0 +1,283 @@
+// Package dbustest provides private D-Bus buses and scripted fake services,
+// for exercising the dbus package end to end without touching the real
+// session or system buses.
+//
+// A Harness starts a private dbus-daemon for each of the session and system
+// buses, and points the DBUS_SESSION_BUS_ADDRESS and DBUS_SYSTEM_BUS_ADDRESS
+// environment variables at them until closed, so that dbus.New connects to
+// the private buses. Since the environment is process-wide, harnesses must not
+// be used from parallel tests.
+package dbustest
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+)
+
+const (
+	daemonBinary       = `dbus-daemon`
+	daemonStartTimeout = 5 * time.Second
+	daemonConfig       = `<!DOCTYPE busconfig PUBLIC "-//freedesktop//DTD D-Bus Bus Configuration 1.0//EN"
+ "http://www.freedesktop.org/standards/dbus/1.0/busconfig.dtd">
+<busconfig>
+  <type>%s</type>
+  <listen>unix:path=%s</listen>
+  <auth>EXTERNAL</auth>
+  <policy context="default">
+    <allow send_destination="*" eavesdrop="true"/>
+    <allow eavesdrop="true"/>
+    <allow own="*"/>
+  </policy>
+</busconfig>
+`
+
+	envSessionBusAddress = `DBUS_SESSION_BUS_ADDRESS`
+	envSystemBusAddress  = `DBUS_SYSTEM_BUS_ADDRESS`
+
+	fdoPropertiesName                    = `org.freedesktop.DBus.Properties`
+	fdoPropertiesSignalPropertiesChanged = fdoPropertiesName + `.PropertiesChanged`
+)
+
+var (
+	// ErrDaemonNotFound is returned when no dbus-daemon binary is available,
+	// tests may wish to skip in this case.
+	ErrDaemonNotFound = errors.New(`dbus-daemon not found`)
+	// ErrTimeout is returned when waiting for an event times out.
+	ErrTimeout = errors.New(`timed out`)
+
+	errUnknownProperty  = dbus.NewError(`org.freedesktop.DBus.Error.UnknownProperty`, []any{`unknown property`})
+	errUnknownInterface = dbus.NewError(`org.freedesktop.DBus.Error.UnknownInterface`, []any{`unknown interface`})
+	errUnknownMenuItem  = errors.New(`unknown menu item`)
+)
+
+// Call records a method call received by a fake service.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// callLog accumulates calls received by a fake service.
+type callLog struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (l *callLog) record(method string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = append(l.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the method calls received, in order.
+func (l *callLog) Calls() []Call {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	calls := make([]Call, len(l.calls))
+	copy(calls, l.calls)
+	return calls
+}
+
+// Bus is a private dbus-daemon instance.
+type Bus struct {
+	address string
+	cmd     *exec.Cmd
+}
+
+// Address returns the bus address, suitable for dbus.Connect.
+func (b *Bus) Address() string {
+	return b.address
+}
+
+// Connect opens a new private connection to the bus.
+func (b *Bus) Connect() (*dbus.Conn, error) {
+	return dbus.Connect(b.address)
+}
+
+func (b *Bus) close() error {
+	if err := b.cmd.Process.Kill(); err != nil {
+		return err
+	}
+	_ = b.cmd.Wait()
+
+	return nil
+}
+
+func startBus(dir, kind string) (*Bus, error) {
+	bin, err := exec.LookPath(daemonBinary)
+	if err != nil {
+		return nil, ErrDaemonNotFound
+	}
+
+	configPath := filepath.Join(dir, kind+`.conf`)
+	config := fmt.Sprintf(daemonConfig, kind, filepath.Join(dir, kind+`.sock`))
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(bin, `--config-file=`+configPath, `--nofork`, `--nopidfile`, `--print-address=1`)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed starting %s bus: %w", kind, err)
+	}
+
+	addrCh := make(chan string, 1)
+	go func() {
+		line, _ := bufio.NewReader(stdout).ReadString('\n')
+		addrCh <- strings.TrimSpace(line)
+	}()
+
+	select {
+	case addr := <-addrCh:
+		if addr == `` {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+			return nil, fmt.Errorf("failed reading %s bus address", kind)
+		}
+		return &Bus{address: addr, cmd: cmd}, nil
+	case <-time.After(daemonStartTimeout):
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return nil, fmt.Errorf("timed out starting %s bus", kind)
+	}
+}
+
+// Harness holds private session and system buses.
+type Harness struct {
+	Session *Bus
+	System  *Bus
+
+	dir     string
+	prevEnv map[string]*string
+}
+
+// Close stops the buses and restores the environment.
+func (h *Harness) Close() error {
+	var errs []error
+	for _, bus := range []*Bus{h.Session, h.System} {
+		if bus != nil {
+			errs = append(errs, bus.close())
+		}
+	}
+	for k, v := range h.prevEnv {
+		if v == nil {
+			errs = append(errs, os.Unsetenv(k))
+		} else {
+			errs = append(errs, os.Setenv(k, *v))
+		}
+	}
+	errs = append(errs, os.RemoveAll(h.dir))
+
+	return errors.Join(errs...)
+}
+
+// New starts private session and system buses, and points the environment at
+// them.
+func New() (*Harness, error) {
+	dir, err := os.MkdirTemp(``, `dbustest`)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Harness{
+		dir:     dir,
+		prevEnv: make(map[string]*string),
+	}
+	if h.Session, err = startBus(dir, `session`); err != nil {
+		_ = h.Close()
+		return nil, err
+	}
+	if h.System, err = startBus(dir, `system`); err != nil {
+		_ = h.Close()
+		return nil, err
+	}
+
+	for k, v := range map[string]string{
+		envSessionBusAddress: h.Session.Address(),
+		envSystemBusAddress:  h.System.Address(),
+	} {
+		if prev, ok := os.LookupEnv(k); ok {
+			h.prevEnv[k] = &prev
+		} else {
+			h.prevEnv[k] = nil
+		}
+		if err := os.Setenv(k, v); err != nil {
+			_ = h.Close()
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
+// WaitEvent reads from ch until an event of the specified kind arrives,
+// discarding any others, or timeout elapses.
+func WaitEvent(ch <-chan *eventv1.Event, kind eventv1.EventKind, timeout time.Duration) (*eventv1.Event, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case evt, ok := <-ch:
+			if !ok {
+				return nil, errors.New(`event channel closed`)
+			}
+			if evt.Kind == kind {
+				return evt, nil
+			}
+		case <-timer.C:
+			return nil, fmt.Errorf("%w waiting for %s", ErrTimeout, kind)
+		}
+	}
+}
+
+// emitTo sends a signal addressed to a single destination, as services do for
+// signals that concern only one client.
+func emitTo(conn *dbus.Conn, dest string, path dbus.ObjectPath, name string, values ...any) error {
+	i := strings.LastIndex(name, `.`)
+	if i == -1 {
+		return errors.New(`invalid signal name`)
+	}
+
+	msg := &dbus.Message{
+		Type: dbus.TypeSignal,
+		Headers: map[dbus.HeaderField]dbus.Variant{
+			dbus.FieldDestination: dbus.MakeVariant(dest),
+			dbus.FieldPath:        dbus.MakeVariant(path),
+			dbus.FieldInterface:   dbus.MakeVariant(name[:i]),
+			dbus.FieldMember:      dbus.MakeVariant(name[i+1:]),
+		},
+		Body: values,
+	}
+	if len(values) > 0 {
+		msg.Headers[dbus.FieldSignature] = dbus.MakeVariant(dbus.SignatureOf(values...))
+	}
+
+	return conn.Send(msg, nil).Err
+}
+
+// variants converts a map of plain values to D-Bus variants.
+func variants(values map[string]any) map[string]dbus.Variant {
+	result := make(map[string]dbus.Variant, len(values))
+	for k, v := range values {
+		if variant, ok := v.(dbus.Variant); ok {
+			result[k] = variant
+			continue
+		}
+		result[k] = dbus.MakeVariant(v)
+	}
+
+	return result
+}
@@ -0,0 +1,216 @@
+package dbustest
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	logindName        = `org.freedesktop.login1`
+	logindPath        = dbus.ObjectPath(`/org/freedesktop/login1`)
+	logindManagerName = logindName + `.Manager`
+
+	logindPropertyBlockInhibited = `BlockInhibited`
+
+	// inhibitHandoffDelay is how long the service keeps its copy of an inhibitor
+	// file descriptor after replying, so that the reply is sent before the copy
+	// is closed. Release is only detected once the copy is closed.
+	inhibitHandoffDelay = 250 * time.Millisecond
+)
+
+// Inhibitor is an inhibitor lock held on a Logind service.
+type Inhibitor struct {
+	What string
+	Who  string
+	Why  string
+	Mode string
+}
+
+type inhibitorLock struct {
+	Inhibitor
+	r *os.File
+	// handoff is the descriptor passed to godbus for the reply, or -1 once
+	// the service has closed it.
+	handoff int
+}
+
+// Logind is a fake org.freedesktop.login1 service, implementing the Inhibit
+// method and BlockInhibited property of the manager. Inhibitor locks are
+// released when the caller closes the returned file descriptor, as they are
+// by logind.
+type Logind struct {
+	callLog
+	conn  *dbus.Conn
+	props *properties
+
+	mu    sync.Mutex
+	locks []*inhibitorLock
+	// blocked holds what is inhibited by means other than locks.
+	blocked []string
+}
+
+// Inhibit implements org.freedesktop.login1.Manager.Inhibit.
+func (l *Logind) Inhibit(what, who, why, mode string) (dbus.UnixFD, *dbus.Error) {
+	l.record(`Inhibit`, what, who, why, mode)
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return 0, dbus.MakeFailedError(err)
+	}
+	// The reply is sent after Inhibit returns, so godbus is given a duplicate
+	// of the write end that the service owns until the handoff completes.
+	fd, err := syscall.Dup(int(w.Fd()))
+	_ = w.Close()
+	if err != nil {
+		_ = r.Close()
+		return 0, dbus.MakeFailedError(err)
+	}
+	lock := &inhibitorLock{
+		Inhibitor: Inhibitor{What: what, Who: who, Why: why, Mode: mode},
+		r:         r,
+		handoff:   fd,
+	}
+
+	l.mu.Lock()
+	l.locks = append(l.locks, lock)
+	l.mu.Unlock()
+	if err := l.update(); err != nil {
+		return 0, dbus.MakeFailedError(err)
+	}
+
+	time.AfterFunc(inhibitHandoffDelay, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		lock.closeHandoff()
+	})
+	go l.watchLock(lock)
+
+	return dbus.UnixFD(fd), nil
+}
+
+// Inhibitors returns the currently held inhibitor locks.
+func (l *Logind) Inhibitors() []Inhibitor {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	result := make([]Inhibitor, len(l.locks))
+	for i, lock := range l.locks {
+		result[i] = lock.Inhibitor
+	}
+
+	return result
+}
+
+// SetBlocked sets what is inhibited independently of any inhibitor locks, as
+// if by another application, and emits PropertiesChanged for BlockInhibited.
+func (l *Logind) SetBlocked(what ...string) error {
+	l.mu.Lock()
+	l.blocked = what
+	l.mu.Unlock()
+
+	return l.update()
+}
+
+// Close releases the service.
+func (l *Logind) Close() error {
+	l.mu.Lock()
+	for _, lock := range l.locks {
+		lock.closeHandoff()
+		_ = lock.r.Close()
+	}
+	l.locks = nil
+	l.mu.Unlock()
+
+	return l.conn.Close()
+}
+
+// closeHandoff closes the descriptor passed to godbus, if it is still open. The
+// Logind mutex must be held.
+func (lock *inhibitorLock) closeHandoff() {
+	if lock.handoff >= 0 {
+		_ = syscall.Close(lock.handoff)
+		lock.handoff = -1
+	}
+}
+
+// watchLock waits for all write ends of the lock pipe to close, then releases
+// the lock.
+func (l *Logind) watchLock(lock *inhibitorLock) {
+	buf := make([]byte, 1)
+	for {
+		if _, err := lock.r.Read(buf); err != nil {
+			break
+		}
+	}
+
+	l.mu.Lock()
+	i := slices.Index(l.locks, lock)
+	if i >= 0 {
+		l.locks = slices.Delete(l.locks, i, i+1)
+	}
+	l.mu.Unlock()
+	if i < 0 {
+		return
+	}
+
+	_ = lock.r.Close()
+	_ = l.update()
+}
+
+// update recalculates BlockInhibited, emitting PropertiesChanged.
+func (l *Logind) update() error {
+	l.mu.Lock()
+	what := slices.Clone(l.blocked)
+	for _, lock := range l.locks {
+		if lock.Mode != `block` {
+			continue
+		}
+		for _, w := range strings.Split(lock.What, `:`) {
+			if !slices.Contains(what, w) {
+				what = append(what, w)
+			}
+		}
+	}
+	l.mu.Unlock()
+
+	return l.props.set(logindManagerName, map[string]dbus.Variant{
+		logindPropertyBlockInhibited: dbus.MakeVariant(strings.Join(what, `:`)),
+	})
+}
+
+// NewLogind starts a fake logind service on bus.
+func NewLogind(bus *Bus) (*Logind, error) {
+	conn, err := bus.Connect()
+	if err != nil {
+		return nil, err
+	}
+	if !conn.SupportsUnixFDs() {
+		_ = conn.Close()
+		return nil, fmt.Errorf("bus connection does not support file descriptor passing")
+	}
+
+	l := &Logind{conn: conn}
+	if l.props, err = exportProperties(conn, logindPath); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	l.props.init(logindManagerName, map[string]dbus.Variant{
+		logindPropertyBlockInhibited: dbus.MakeVariant(``),
+	})
+	if err := conn.Export(l, logindPath, logindManagerName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := requestName(conn, logindName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return l, nil
+}
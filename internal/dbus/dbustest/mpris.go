@@ -0,0 +1,143 @@
+package dbustest
+
+import (
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	mprisNamePrefix = `org.mpris.MediaPlayer2.`
+	mprisPath       = dbus.ObjectPath(`/org/mpris/MediaPlayer2`)
+	mprisName       = `org.mpris.MediaPlayer2`
+	mprisPlayerName = mprisName + `.Player`
+
+	mprisPropertyPlaybackStatus = `PlaybackStatus`
+
+	// PlaybackPlaying is the MPRIS PlaybackStatus of a playing player.
+	PlaybackPlaying = `Playing`
+	// PlaybackPaused is the MPRIS PlaybackStatus of a paused player.
+	PlaybackPaused = `Paused`
+	// PlaybackStopped is the MPRIS PlaybackStatus of a stopped player.
+	PlaybackStopped = `Stopped`
+)
+
+// MediaPlayer is a fake MPRIS media player. Root properties (for example
+// `Identity`) and player properties (for example `PlaybackStatus` and
+// `Metadata`) use the names and types of the MPRIS specification. Playback
+// control methods are recorded, and update PlaybackStatus as a player would.
+type MediaPlayer struct {
+	callLog
+	conn  *dbus.Conn
+	name  string
+	props *properties
+}
+
+// Name returns the well-known bus name of the player.
+func (m *MediaPlayer) Name() string {
+	return m.name
+}
+
+// PlayPause implements org.mpris.MediaPlayer2.Player.PlayPause.
+func (m *MediaPlayer) PlayPause() *dbus.Error {
+	m.record(`PlayPause`)
+	status := PlaybackPlaying
+	if m.props.get(mprisPlayerName, mprisPropertyPlaybackStatus) == PlaybackPlaying {
+		status = PlaybackPaused
+	}
+
+	return m.setPlayback(status)
+}
+
+// Play implements org.mpris.MediaPlayer2.Player.Play.
+func (m *MediaPlayer) Play() *dbus.Error {
+	m.record(`Play`)
+	return m.setPlayback(PlaybackPlaying)
+}
+
+// Pause implements org.mpris.MediaPlayer2.Player.Pause.
+func (m *MediaPlayer) Pause() *dbus.Error {
+	m.record(`Pause`)
+	return m.setPlayback(PlaybackPaused)
+}
+
+// Stop implements org.mpris.MediaPlayer2.Player.Stop.
+func (m *MediaPlayer) Stop() *dbus.Error {
+	m.record(`Stop`)
+	return m.setPlayback(PlaybackStopped)
+}
+
+// Next implements org.mpris.MediaPlayer2.Player.Next.
+func (m *MediaPlayer) Next() *dbus.Error {
+	m.record(`Next`)
+	return nil
+}
+
+// Previous implements org.mpris.MediaPlayer2.Player.Previous.
+func (m *MediaPlayer) Previous() *dbus.Error {
+	m.record(`Previous`)
+	return nil
+}
+
+// SeekOffset implements org.mpris.MediaPlayer2.Player.Seek, renamed to avoid
+// confusion with io.Seeker.
+func (m *MediaPlayer) SeekOffset(offset int64) *dbus.Error {
+	m.record(`Seek`, offset)
+	return nil
+}
+
+// SetPosition implements org.mpris.MediaPlayer2.Player.SetPosition.
+func (m *MediaPlayer) SetPosition(trackID dbus.ObjectPath, pos int64) *dbus.Error {
+	m.record(`SetPosition`, trackID, pos)
+	return nil
+}
+
+// Set updates player properties, emitting PropertiesChanged.
+func (m *MediaPlayer) Set(props map[string]any) error {
+	return m.props.set(mprisPlayerName, variants(props))
+}
+
+// Close releases the player, as if it exited.
+func (m *MediaPlayer) Close() error {
+	return m.conn.Close()
+}
+
+func (m *MediaPlayer) setPlayback(status string) *dbus.Error {
+	if m.props.get(mprisPlayerName, mprisPropertyPlaybackStatus) == status {
+		return nil
+	}
+	if err := m.Set(map[string]any{mprisPropertyPlaybackStatus: status}); err != nil {
+		return dbus.MakeFailedError(err)
+	}
+
+	return nil
+}
+
+// NewMediaPlayer starts a fake media player on bus, owning the name
+// org.mpris.MediaPlayer2.<name>, with the supplied root and player properties.
+func NewMediaPlayer(bus *Bus, name string, root, player map[string]any) (*MediaPlayer, error) {
+	conn, err := bus.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &MediaPlayer{
+		conn: conn,
+		name: mprisNamePrefix + name,
+	}
+	if m.props, err = exportProperties(conn, mprisPath); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	m.props.init(mprisName, variants(root))
+	m.props.init(mprisPlayerName, variants(player))
+	if err := conn.ExportWithMap(m, map[string]string{`SeekOffset`: `Seek`}, mprisPath, mprisPlayerName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	// Claim the name last, since clients query properties on NameOwnerChanged.
+	if err := requestName(conn, m.name); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,192 @@
+package dbustest
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	portalName                  = `org.freedesktop.portal.Desktop`
+	portalPath                  = dbus.ObjectPath(`/org/freedesktop/portal/desktop`)
+	portalRequestSignalResponse = `org.freedesktop.portal.Request.Response`
+	portalSessionName           = `org.freedesktop.portal.Session`
+
+	globalShortcutsName              = `org.freedesktop.portal.GlobalShortcuts`
+	globalShortcutsSignalActivated   = globalShortcutsName + `.Activated`
+	globalShortcutsSignalDeactivated = globalShortcutsName + `.Deactivated`
+
+	portalResponseSuccess = uint32(0)
+)
+
+// Shortcut is a global shortcut bound by a portal client.
+type Shortcut struct {
+	ID   string
+	Data map[string]dbus.Variant
+}
+
+type shortcutSession struct {
+	path      dbus.ObjectPath
+	owner     string
+	shortcuts []Shortcut
+}
+
+// GlobalShortcuts is a fake org.freedesktop.portal.GlobalShortcuts portal.
+// Sessions and bindings always succeed, and shortcuts are triggered with
+// Activate and Deactivate.
+type GlobalShortcuts struct {
+	callLog
+	conn *dbus.Conn
+
+	mu       sync.Mutex
+	sessions map[dbus.ObjectPath]*shortcutSession
+}
+
+// CreateSession implements org.freedesktop.portal.GlobalShortcuts.CreateSession.
+func (g *GlobalShortcuts) CreateSession(options map[string]dbus.Variant, sender dbus.Sender) (dbus.ObjectPath, *dbus.Error) {
+	g.record(`CreateSession`, options)
+
+	sessionToken, _ := options[`session_handle_token`].Value().(string)
+	session := &shortcutSession{
+		path:  portalObjectPath(`session`, sender, sessionToken),
+		owner: string(sender),
+	}
+	if err := g.conn.ExportMethodTable(map[string]any{
+		`Close`: func() *dbus.Error {
+			g.closeSession(session.path)
+			return nil
+		},
+	}, session.path, portalSessionName); err != nil {
+		return ``, dbus.MakeFailedError(err)
+	}
+	g.mu.Lock()
+	g.sessions[session.path] = session
+	g.mu.Unlock()
+
+	return g.respond(options, sender, map[string]dbus.Variant{
+		`session_handle`: dbus.MakeVariant(string(session.path)),
+	})
+}
+
+// BindShortcuts implements org.freedesktop.portal.GlobalShortcuts.BindShortcuts.
+func (g *GlobalShortcuts) BindShortcuts(sessionHandle dbus.ObjectPath, shortcuts []Shortcut, parentWindow string, options map[string]dbus.Variant, sender dbus.Sender) (dbus.ObjectPath, *dbus.Error) {
+	g.record(`BindShortcuts`, sessionHandle, shortcuts, parentWindow, options)
+
+	g.mu.Lock()
+	session, ok := g.sessions[sessionHandle]
+	if ok {
+		session.shortcuts = shortcuts
+	}
+	g.mu.Unlock()
+	if !ok {
+		return ``, dbus.MakeFailedError(fmt.Errorf("unknown session: %s", sessionHandle))
+	}
+
+	return g.respond(options, sender, map[string]dbus.Variant{
+		`shortcuts`: dbus.MakeVariant(shortcuts),
+	})
+}
+
+// Shortcuts returns the shortcuts bound to open sessions.
+func (g *GlobalShortcuts) Shortcuts() []Shortcut {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var result []Shortcut
+	for _, session := range g.sessions {
+		result = append(result, session.shortcuts...)
+	}
+
+	return result
+}
+
+// Activate emits Activated for the shortcut to the sessions that bound it.
+func (g *GlobalShortcuts) Activate(id string) error {
+	return g.trigger(globalShortcutsSignalActivated, id)
+}
+
+// Deactivate emits Deactivated for the shortcut to the sessions that bound it.
+func (g *GlobalShortcuts) Deactivate(id string) error {
+	return g.trigger(globalShortcutsSignalDeactivated, id)
+}
+
+// Close releases the portal.
+func (g *GlobalShortcuts) Close() error {
+	return g.conn.Close()
+}
+
+// closeSession implements org.freedesktop.portal.Session.Close.
+func (g *GlobalShortcuts) closeSession(path dbus.ObjectPath) {
+	g.record(`Close`, path)
+	g.mu.Lock()
+	delete(g.sessions, path)
+	g.mu.Unlock()
+	_ = g.conn.Export(nil, path, portalSessionName)
+}
+
+func (g *GlobalShortcuts) trigger(signal, id string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	found := false
+	timestamp := uint64(time.Now().UnixMilli())
+	for _, session := range g.sessions {
+		for _, shortcut := range session.shortcuts {
+			if shortcut.ID != id {
+				continue
+			}
+			found = true
+			if err := emitTo(g.conn, session.owner, portalPath, signal, session.path, id, timestamp, map[string]dbus.Variant{}); err != nil {
+				return err
+			}
+		}
+	}
+	if !found {
+		return fmt.Errorf("shortcut not bound: %s", id)
+	}
+
+	return nil
+}
+
+// respond emits a successful Response on the request object for options, and
+// returns the request path.
+func (g *GlobalShortcuts) respond(options map[string]dbus.Variant, sender dbus.Sender, results map[string]dbus.Variant) (dbus.ObjectPath, *dbus.Error) {
+	token, _ := options[`handle_token`].Value().(string)
+	request := portalObjectPath(`request`, sender, token)
+	if err := emitTo(g.conn, string(sender), request, portalRequestSignalResponse, portalResponseSuccess, results); err != nil {
+		return ``, dbus.MakeFailedError(err)
+	}
+
+	return request, nil
+}
+
+// portalObjectPath returns the request or session object path for a sender
+// and token, as specified by the portal API.
+func portalObjectPath(kind string, sender dbus.Sender, token string) dbus.ObjectPath {
+	name := strings.ReplaceAll(strings.TrimPrefix(string(sender), `:`), `.`, `_`)
+	return dbus.ObjectPath(fmt.Sprintf("%s/%s/%s/%s", portalPath, kind, name, token))
+}
+
+// NewGlobalShortcuts starts a fake GlobalShortcuts portal on bus.
+func NewGlobalShortcuts(bus *Bus) (*GlobalShortcuts, error) {
+	conn, err := bus.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	g := &GlobalShortcuts{
+		conn:     conn,
+		sessions: make(map[dbus.ObjectPath]*shortcutSession),
+	}
+	if err := conn.Export(g, portalPath, globalShortcutsName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := requestName(conn, portalName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return g, nil
+}
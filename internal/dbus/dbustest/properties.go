@@ -0,0 +1,101 @@
+package dbustest
+
+import (
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// properties implements org.freedesktop.DBus.Properties for a fake object,
+// emitting a single PropertiesChanged signal for each batch of updates.
+type properties struct {
+	conn *dbus.Conn
+	path dbus.ObjectPath
+
+	mu     sync.RWMutex
+	values map[string]map[string]dbus.Variant
+}
+
+func (p *properties) Get(iface, property string) (dbus.Variant, *dbus.Error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	props, ok := p.values[iface]
+	if !ok {
+		return dbus.Variant{}, errUnknownInterface
+	}
+	v, ok := props[property]
+	if !ok {
+		return dbus.Variant{}, errUnknownProperty
+	}
+
+	return v, nil
+}
+
+func (p *properties) GetAll(iface string) (map[string]dbus.Variant, *dbus.Error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	props, ok := p.values[iface]
+	if !ok {
+		return nil, errUnknownInterface
+	}
+	result := make(map[string]dbus.Variant, len(props))
+	for k, v := range props {
+		result[k] = v
+	}
+
+	return result, nil
+}
+
+func (p *properties) Set(iface, property string, value dbus.Variant) *dbus.Error {
+	if err := p.set(iface, map[string]dbus.Variant{property: value}); err != nil {
+		return dbus.MakeFailedError(err)
+	}
+
+	return nil
+}
+
+// get returns a single property value, or nil if unset.
+func (p *properties) get(iface, property string) any {
+	v, err := p.Get(iface, property)
+	if err != nil {
+		return nil
+	}
+
+	return v.Value()
+}
+
+// init replaces the properties of iface without signalling.
+func (p *properties) init(iface string, values map[string]dbus.Variant) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.values[iface] = values
+}
+
+// set updates the properties of iface and signals the change.
+func (p *properties) set(iface string, values map[string]dbus.Variant) error {
+	p.mu.Lock()
+	props, ok := p.values[iface]
+	if !ok {
+		props = make(map[string]dbus.Variant, len(values))
+		p.values[iface] = props
+	}
+	for k, v := range values {
+		props[k] = v
+	}
+	p.mu.Unlock()
+
+	return p.conn.Emit(p.path, fdoPropertiesSignalPropertiesChanged, iface, values, []string{})
+}
+
+func exportProperties(conn *dbus.Conn, path dbus.ObjectPath) (*properties, error) {
+	p := &properties{
+		conn:   conn,
+		path:   path,
+		values: make(map[string]map[string]dbus.Variant),
+	}
+	if err := conn.Export(p, path, fdoPropertiesName); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
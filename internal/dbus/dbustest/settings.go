@@ -0,0 +1,97 @@
+package dbustest
+
+import (
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	settingsName                 = `org.freedesktop.portal.Settings`
+	settingsSignalSettingChanged = settingsName + `.SettingChanged`
+)
+
+var errSettingNotFound = dbus.NewError(`org.freedesktop.portal.Error.NotFound`, []any{`requested setting not found`})
+
+// Settings is a fake org.freedesktop.portal.Settings portal, serving values
+// that are updated with Set.
+type Settings struct {
+	callLog
+	conn *dbus.Conn
+
+	mu     sync.Mutex
+	values map[string]map[string]dbus.Variant
+}
+
+// ReadOne implements org.freedesktop.portal.Settings.ReadOne.
+func (s *Settings) ReadOne(namespace, key string) (dbus.Variant, *dbus.Error) {
+	s.record(`ReadOne`, namespace, key)
+	v, ok := s.lookup(namespace, key)
+	if !ok {
+		return dbus.Variant{}, errSettingNotFound
+	}
+
+	return v, nil
+}
+
+// Read implements the deprecated org.freedesktop.portal.Settings.Read, which
+// wraps the value in an additional variant.
+func (s *Settings) Read(namespace, key string) (dbus.Variant, *dbus.Error) {
+	s.record(`Read`, namespace, key)
+	v, ok := s.lookup(namespace, key)
+	if !ok {
+		return dbus.Variant{}, errSettingNotFound
+	}
+
+	return dbus.MakeVariant(v), nil
+}
+
+// Set updates a setting and emits SettingChanged.
+func (s *Settings) Set(namespace, key string, value any) error {
+	v := dbus.MakeVariant(value)
+	s.mu.Lock()
+	if s.values[namespace] == nil {
+		s.values[namespace] = make(map[string]dbus.Variant)
+	}
+	s.values[namespace][key] = v
+	s.mu.Unlock()
+
+	return s.conn.Emit(portalPath, settingsSignalSettingChanged, namespace, key, v)
+}
+
+// Close releases the portal.
+func (s *Settings) Close() error {
+	return s.conn.Close()
+}
+
+func (s *Settings) lookup(namespace, key string) (dbus.Variant, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[namespace][key]
+
+	return v, ok
+}
+
+// NewSettings starts a fake Settings portal on bus. It claims the portal bus
+// name, so may not be started alongside NewGlobalShortcuts on the same bus.
+func NewSettings(bus *Bus) (*Settings, error) {
+	conn, err := bus.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Settings{
+		conn:   conn,
+		values: make(map[string]map[string]dbus.Variant),
+	}
+	if err := conn.Export(s, portalPath, settingsName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := requestName(conn, portalName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
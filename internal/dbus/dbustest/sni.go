@@ -0,0 +1,256 @@
+package dbustest
+
+import (
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	snwName                         = `org.kde.StatusNotifierWatcher`
+	snwPath                         = dbus.ObjectPath(`/StatusNotifierWatcher`)
+	snwMethodRegisterStatusNotifier = snwName + `.RegisterStatusNotifierItem`
+
+	sniName = `org.kde.StatusNotifierItem`
+	sniPath = dbus.ObjectPath(`/StatusNotifierItem`)
+
+	sniPropertyTitle   = `Title`
+	sniPropertyTooltip = `ToolTip`
+	sniPropertyStatus  = `Status`
+	sniPropertyMenu    = `Menu`
+
+	sniSignalNewTitle   = sniName + `.NewTitle`
+	sniSignalNewIcon    = sniName + `.NewIcon`
+	sniSignalNewToolTip = sniName + `.NewToolTip`
+	sniSignalNewStatus  = sniName + `.NewStatus`
+
+	menuName                = `com.canonical.dbusmenu`
+	menuPath                = dbus.ObjectPath(`/MenuBar`)
+	menuSignalLayoutUpdated = menuName + `.LayoutUpdated`
+)
+
+// MenuItem is a dbusmenu layout node. Properties use the names and types of
+// the com.canonical.dbusmenu specification, for example `label` as string.
+type MenuItem struct {
+	ID         int32
+	Properties map[string]any
+	Children   []MenuItem
+}
+
+// menuLayout is the wire format of a dbusmenu layout node, (ia{sv}av).
+type menuLayout struct {
+	ID         int32
+	Properties map[string]dbus.Variant
+	Children   []dbus.Variant
+}
+
+func (m MenuItem) layout() menuLayout {
+	l := menuLayout{
+		ID:         m.ID,
+		Properties: variants(m.Properties),
+		Children:   make([]dbus.Variant, len(m.Children)),
+	}
+	for i, child := range m.Children {
+		l.Children[i] = dbus.MakeVariant(child.layout())
+	}
+
+	return l
+}
+
+// StatusNotifierItem is a fake SNI application, exporting an item and a
+// dbusmenu, and registering with the StatusNotifierWatcher. Item properties
+// use the names and types of the org.kde.StatusNotifierItem specification,
+// for example `IconName` as string. Activation and menu methods are recorded.
+type StatusNotifierItem struct {
+	callLog
+	conn  *dbus.Conn
+	props *properties
+	menu  *dbusMenu
+}
+
+// BusName returns the unique bus name of the item.
+func (s *StatusNotifierItem) BusName() string {
+	return s.conn.Names()[0]
+}
+
+// Activate implements org.kde.StatusNotifierItem.Activate.
+func (s *StatusNotifierItem) Activate(x, y int32) *dbus.Error {
+	s.record(`Activate`, x, y)
+	return nil
+}
+
+// SecondaryActivate implements org.kde.StatusNotifierItem.SecondaryActivate.
+func (s *StatusNotifierItem) SecondaryActivate(x, y int32) *dbus.Error {
+	s.record(`SecondaryActivate`, x, y)
+	return nil
+}
+
+// ContextMenu implements org.kde.StatusNotifierItem.ContextMenu.
+func (s *StatusNotifierItem) ContextMenu(x, y int32) *dbus.Error {
+	s.record(`ContextMenu`, x, y)
+	return nil
+}
+
+// Scroll implements org.kde.StatusNotifierItem.Scroll.
+func (s *StatusNotifierItem) Scroll(delta int32, orientation string) *dbus.Error {
+	s.record(`Scroll`, delta, orientation)
+	return nil
+}
+
+// Register the item with the StatusNotifierWatcher.
+func (s *StatusNotifierItem) Register() error {
+	return s.conn.Object(snwName, snwPath).Call(snwMethodRegisterStatusNotifier, 0, s.BusName()).Err
+}
+
+// SetTitle updates the title and emits NewTitle.
+func (s *StatusNotifierItem) SetTitle(title string) error {
+	return s.update(sniSignalNewTitle, map[string]any{sniPropertyTitle: title})
+}
+
+// SetTooltip updates the tooltip and emits NewToolTip.
+func (s *StatusNotifierItem) SetTooltip(iconName, title, body string) error {
+	tooltip := struct {
+		IconName   string
+		IconPixmap []struct {
+			Width  int32
+			Height int32
+			Data   []byte
+		}
+		Title string
+		Body  string
+	}{IconName: iconName, Title: title, Body: body}
+
+	return s.update(sniSignalNewToolTip, map[string]any{sniPropertyTooltip: tooltip})
+}
+
+// SetStatus updates the status (Passive, Active or NeedsAttention) and emits
+// NewStatus.
+func (s *StatusNotifierItem) SetStatus(status string) error {
+	return s.update(sniSignalNewStatus, map[string]any{sniPropertyStatus: status}, status)
+}
+
+// SetIcon updates icon properties and emits NewIcon.
+func (s *StatusNotifierItem) SetIcon(props map[string]any) error {
+	return s.update(sniSignalNewIcon, props)
+}
+
+// SetMenu replaces the menu layout, and emits LayoutUpdated.
+func (s *StatusNotifierItem) SetMenu(root MenuItem) error {
+	revision := s.menu.setLayout(root)
+	return s.conn.Emit(menuPath, menuSignalLayoutUpdated, revision, int32(0))
+}
+
+// MenuCalls returns the dbusmenu method calls received, in order.
+func (s *StatusNotifierItem) MenuCalls() []Call {
+	return s.menu.Calls()
+}
+
+// Close releases the item, as if the application exited.
+func (s *StatusNotifierItem) Close() error {
+	return s.conn.Close()
+}
+
+// update sets properties without emitting PropertiesChanged, which SNI items
+// do not use, then emits the item signal.
+func (s *StatusNotifierItem) update(signal string, props map[string]any, values ...any) error {
+	s.props.mu.Lock()
+	for k, v := range variants(props) {
+		s.props.values[sniName][k] = v
+	}
+	s.props.mu.Unlock()
+
+	return s.conn.Emit(sniPath, signal, values...)
+}
+
+// dbusMenu implements com.canonical.dbusmenu over a static layout.
+type dbusMenu struct {
+	callLog
+
+	mu       sync.RWMutex
+	revision uint32
+	root     MenuItem
+}
+
+// GetLayout implements com.canonical.dbusmenu.GetLayout.
+func (d *dbusMenu) GetLayout(parentID int32, recursionDepth int32, propertyNames []string) (uint32, menuLayout, *dbus.Error) {
+	d.record(`GetLayout`, parentID, recursionDepth, propertyNames)
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	item, ok := findMenuItem(d.root, parentID)
+	if !ok {
+		return 0, menuLayout{}, dbus.MakeFailedError(errUnknownMenuItem)
+	}
+
+	return d.revision, item.layout(), nil
+}
+
+// AboutToShow implements com.canonical.dbusmenu.AboutToShow.
+func (d *dbusMenu) AboutToShow(id int32) (bool, *dbus.Error) {
+	d.record(`AboutToShow`, id)
+	return false, nil
+}
+
+// Event implements com.canonical.dbusmenu.Event.
+func (d *dbusMenu) Event(id int32, eventID string, data dbus.Variant, timestamp uint32) *dbus.Error {
+	d.record(`Event`, id, eventID, data.Value(), timestamp)
+	return nil
+}
+
+func (d *dbusMenu) setLayout(root MenuItem) uint32 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.revision += 1
+	d.root = root
+
+	return d.revision
+}
+
+func findMenuItem(item MenuItem, id int32) (MenuItem, bool) {
+	if item.ID == id {
+		return item, true
+	}
+	for _, child := range item.Children {
+		if found, ok := findMenuItem(child, id); ok {
+			return found, true
+		}
+	}
+
+	return MenuItem{}, false
+}
+
+// NewStatusNotifierItem starts a fake SNI application on bus, with the
+// supplied item properties and menu, and registers it with the
+// StatusNotifierWatcher. The `Menu` property is set automatically.
+func NewStatusNotifierItem(bus *Bus, props map[string]any, menu MenuItem) (*StatusNotifierItem, error) {
+	conn, err := bus.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &StatusNotifierItem{
+		conn: conn,
+		menu: &dbusMenu{},
+	}
+	s.menu.setLayout(menu)
+	if s.props, err = exportProperties(conn, sniPath); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	itemProps := variants(props)
+	itemProps[sniPropertyMenu] = dbus.MakeVariant(menuPath)
+	s.props.init(sniName, itemProps)
+	if err := conn.Export(s, sniPath, sniName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := conn.Export(s.menu, menuPath, menuName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := s.Register(); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
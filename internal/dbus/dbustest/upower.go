@@ -0,0 +1,126 @@
+package dbustest
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	upowerName       = `org.freedesktop.UPower`
+	upowerPath       = dbus.ObjectPath(`/org/freedesktop/UPower`)
+	upowerDeviceName = upowerName + `.Device`
+	upowerDevicePath = `/org/freedesktop/UPower/devices/`
+
+	// UPowerDisplayDevice is the name of the composite display device.
+	UPowerDisplayDevice = `DisplayDevice`
+)
+
+// UPower is a fake org.freedesktop.UPower service. Device properties use the
+// names and types of the org.freedesktop.UPower.Device interface, for
+// example `Percentage` as float64 and `State` as uint32.
+type UPower struct {
+	conn *dbus.Conn
+
+	mu      sync.RWMutex
+	devices map[string]*properties
+}
+
+// GetDisplayDevice implements org.freedesktop.UPower.GetDisplayDevice.
+func (u *UPower) GetDisplayDevice() (dbus.ObjectPath, *dbus.Error) {
+	return upowerDevicePath + UPowerDisplayDevice, nil
+}
+
+// EnumerateDevices implements org.freedesktop.UPower.EnumerateDevices.
+func (u *UPower) EnumerateDevices() ([]dbus.ObjectPath, *dbus.Error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	paths := make([]dbus.ObjectPath, 0, len(u.devices))
+	for name := range u.devices {
+		if name == UPowerDisplayDevice {
+			continue
+		}
+		paths = append(paths, dbus.ObjectPath(upowerDevicePath+name))
+	}
+
+	return paths, nil
+}
+
+// AddDevice exports a device with the supplied properties.
+func (u *UPower) AddDevice(name string, props map[string]any) (dbus.ObjectPath, error) {
+	path := dbus.ObjectPath(upowerDevicePath + name)
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if _, ok := u.devices[name]; ok {
+		return ``, fmt.Errorf("device already exists: %s", name)
+	}
+	p, err := exportProperties(u.conn, path)
+	if err != nil {
+		return ``, err
+	}
+	p.init(upowerDeviceName, variants(props))
+	u.devices[name] = p
+
+	return path, nil
+}
+
+// SetDevice updates properties of the named device, emitting
+// PropertiesChanged.
+func (u *UPower) SetDevice(name string, props map[string]any) error {
+	u.mu.RLock()
+	p, ok := u.devices[name]
+	u.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("device not found: %s", name)
+	}
+
+	return p.set(upowerDeviceName, variants(props))
+}
+
+// Close releases the service.
+func (u *UPower) Close() error {
+	return u.conn.Close()
+}
+
+// NewUPower starts a fake UPower service on bus, with a display device holding
+// the supplied properties.
+func NewUPower(bus *Bus, display map[string]any) (*UPower, error) {
+	conn, err := bus.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	u := &UPower{
+		conn:    conn,
+		devices: make(map[string]*properties),
+	}
+	if _, err := u.AddDevice(UPowerDisplayDevice, display); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := conn.Export(u, upowerPath, upowerName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := requestName(conn, upowerName); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// requestName claims name on conn, failing if it is already owned.
+func requestName(conn *dbus.Conn, name string) error {
+	reply, err := conn.RequestName(name, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		return err
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		return fmt.Errorf("failed claiming bus name %s: code %d", name, reply)
+	}
+
+	return nil
+}
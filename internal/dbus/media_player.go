@@ -16,6 +16,7 @@ import (
 const (
 	playbackPlaying = `Playing`
 	playbackPaused  = `Paused`
+	playbackStopped = `Stopped`
 )
 
 type mediaPlayer struct {
@@ -56,6 +57,8 @@ func (p player) eventState() eventv1.MediaPlayerState {
 		return eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_PLAYING
 	case playbackPaused:
 		return eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_PAUSED
+	case playbackStopped:
+		return eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_STOPPED
 	}
 	return eventv1.MediaPlayerState_MEDIA_PLAYER_STATE_UNSPECIFIED
 }
@@ -181,10 +184,15 @@ func (m *mediaPlayer) handleNameOwnerChanged(sig *dbus.Signal) (player, bool) {
 
 	if newOwner == `` {
 		m.mu.Lock()
+		_, known := m.players[oldOwner]
 		delete(m.players, oldOwner)
 		m.mu.Unlock()
 
 		p, _, ok := m.latestPlayerCopy()
+		if !ok && known {
+			// The last player exited, report that nothing remains to display.
+			return player{playback: playbackStopped, updatedAt: time.Now()}, true
+		}
 		return p, ok
 	}
 
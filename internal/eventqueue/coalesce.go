@@ -0,0 +1,40 @@
+package eventqueue
+
+import (
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// CoalesceKey returns the key identifying the state that evt describes, or an
+// empty string if evt must never be coalesced. Events sharing a key supersede
+// one another.
+func CoalesceKey(evt *eventv1.Event) string {
+	var (
+		id  func() string
+		msg proto.Message
+	)
+	switch evt.Kind {
+	case eventv1.EventKind_EVENT_KIND_AUDIO_SINK_CHANGE:
+		v := &eventv1.AudioSinkChangeValue{}
+		msg, id = v, v.GetId
+	case eventv1.EventKind_EVENT_KIND_AUDIO_SOURCE_CHANGE:
+		v := &eventv1.AudioSourceChangeValue{}
+		msg, id = v, v.GetId
+	case eventv1.EventKind_EVENT_KIND_DBUS_BRIGHTNESS_CHANGE:
+		v := &eventv1.BrightnessChangeValue{}
+		msg, id = v, v.GetId
+	case eventv1.EventKind_EVENT_KIND_DBUS_POWER_CHANGE:
+		v := &eventv1.PowerChangeValue{}
+		msg, id = v, v.GetId
+	case eventv1.EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE, eventv1.EventKind_EVENT_KIND_DBUS_COLOR_SCHEME_CHANGE:
+		return evt.Kind.String()
+	default:
+		return ``
+	}
+
+	if evt.Data == nil || evt.Data.UnmarshalTo(msg) != nil {
+		return ``
+	}
+
+	return evt.Kind.String() + `/` + id()
+}
@@ -0,0 +1,216 @@
+// Package eventqueue provides bounded event queues that coalesce superseding state events.
+package eventqueue
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+)
+
+const (
+	// ReportInterval is the minimum interval between queue statistics reports.
+	ReportInterval = 30 * time.Second
+	// LagThreshold is the queue latency above which an event is counted as lagged.
+	LagThreshold = time.Second
+)
+
+type entry struct {
+	evt      *eventv1.Event
+	key      string
+	queuedAt time.Time
+}
+
+// Stats holds queue counters accumulated since the previous report.
+type Stats struct {
+	Dropped   uint64
+	Coalesced uint64
+	Lagged    uint64
+	MaxLag    time.Duration
+}
+
+// Queue is a bounded FIFO of events. When full, the oldest event is dropped. A
+// pushed event that supersedes a pending event (for example, a newer state for
+// the same audio sink) replaces it, moving to the back of the queue.
+type Queue struct {
+	size int
+
+	mu       sync.Mutex
+	entries  []*entry
+	pending  map[string]*entry
+	stats    Stats
+	signalCh chan struct{}
+}
+
+// Push an event onto the queue, never blocking.
+func (q *Queue) Push(evt *eventv1.Event) {
+	e := &entry{evt: evt, key: CoalesceKey(evt), queuedAt: time.Now()}
+
+	q.mu.Lock()
+	if e.key != `` {
+		if prev, ok := q.pending[e.key]; ok {
+			q.remove(prev)
+			q.stats.Coalesced += 1
+		}
+		q.pending[e.key] = e
+	}
+	for len(q.entries) >= q.size {
+		q.dropOldest()
+	}
+	q.entries = append(q.entries, e)
+	q.mu.Unlock()
+
+	select {
+	case q.signalCh <- struct{}{}:
+	default:
+	}
+}
+
+// Clear discards all pending events.
+func (q *Queue) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	clear(q.entries)
+	q.entries = q.entries[:0]
+	clear(q.pending)
+}
+
+// Len returns the number of pending events.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.entries)
+}
+
+// TakeStats returns the counters accumulated since the previous call, and
+// resets them.
+func (q *Queue) TakeStats() Stats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	stats := q.stats
+	q.stats = Stats{}
+	return stats
+}
+
+// Run delivers queued events to fn in order until quitCh is closed, reporting
+// queue statistics to log periodically.
+func (q *Queue) Run(quitCh <-chan struct{}, log hclog.Logger, fn func(evt *eventv1.Event)) {
+	q.RunBatch(quitCh, log, 1, func(evts []*eventv1.Event) {
+		fn(evts[0])
+	})
+}
+
+// RunBatch delivers queued events to fn in order until quitCh is closed,
+// reporting queue statistics to log periodically. Each call to fn receives all
+// events pending at the time, up to limit, so that events accumulated while fn
+// is busy are delivered together. The slice passed to fn must not be retained.
+func (q *Queue) RunBatch(quitCh <-chan struct{}, log hclog.Logger, limit int, fn func(evts []*eventv1.Event)) {
+	if limit < 1 {
+		limit = 1
+	}
+	ticker := time.NewTicker(ReportInterval)
+	defer ticker.Stop()
+
+	batch := make([]*eventv1.Event, 0, limit)
+	for {
+		select {
+		case <-quitCh:
+			return
+		default:
+		}
+
+		if batch = q.popBatch(batch[:0], limit); len(batch) > 0 {
+			fn(batch)
+			clear(batch)
+			select {
+			case <-ticker.C:
+				q.report(log)
+			default:
+			}
+			continue
+		}
+
+		select {
+		case <-quitCh:
+			return
+		case <-q.signalCh:
+		case <-ticker.C:
+			q.report(log)
+		}
+	}
+}
+
+// popBatch appends up to limit pending events to batch.
+func (q *Queue) popBatch(batch []*eventv1.Event, limit int) []*eventv1.Event {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.entries) > 0 && len(batch) < limit {
+		e := q.shift()
+		lag := time.Since(e.queuedAt)
+		if lag > LagThreshold {
+			q.stats.Lagged += 1
+		}
+		if lag > q.stats.MaxLag {
+			q.stats.MaxLag = lag
+		}
+
+		batch = append(batch, e.evt)
+	}
+
+	return batch
+}
+
+// shift removes and returns the oldest entry.
+func (q *Queue) shift() *entry {
+	e := q.entries[0]
+	q.entries[0] = nil
+	q.entries = q.entries[1:]
+	if e.key != `` && q.pending[e.key] == e {
+		delete(q.pending, e.key)
+	}
+
+	return e
+}
+
+// remove deletes a superseded entry, so that coalesced events never occupy
+// space in the queue.
+func (q *Queue) remove(e *entry) {
+	if i := slices.Index(q.entries, e); i >= 0 {
+		q.entries = slices.Delete(q.entries, i, i+1)
+	}
+}
+
+func (q *Queue) dropOldest() {
+	if len(q.entries) == 0 {
+		return
+	}
+	q.shift()
+	q.stats.Dropped += 1
+}
+
+func (q *Queue) report(log hclog.Logger) {
+	stats := q.TakeStats()
+	switch {
+	case stats.Dropped > 0 || stats.Lagged > 0:
+		log.Warn(`Event queue congested`, `dropped`, stats.Dropped, `lagged`, stats.Lagged, `coalesced`, stats.Coalesced, `maxLag`, stats.MaxLag, `pending`, q.Len())
+	case stats.Coalesced > 0:
+		log.Debug(`Event queue coalesced events`, `coalesced`, stats.Coalesced, `maxLag`, stats.MaxLag)
+	}
+}
+
+// New instantiates a queue holding at most size pending events.
+func New(size int) *Queue {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Queue{
+		size:     size,
+		entries:  make([]*entry, 0, size),
+		pending:  make(map[string]*entry),
+		signalCh: make(chan struct{}, 1),
+	}
+}
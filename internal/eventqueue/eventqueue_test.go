@@ -0,0 +1,132 @@
+package eventqueue
+
+import (
+	"testing"
+
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func sinkEvent(t *testing.T, id string, volume int32) *eventv1.Event {
+	t.Helper()
+	data, err := anypb.New(&eventv1.AudioSinkChangeValue{Id: id, Volume: volume})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &eventv1.Event{Kind: eventv1.EventKind_EVENT_KIND_AUDIO_SINK_CHANGE, Data: data}
+}
+
+func titleEvent(t *testing.T, title string) *eventv1.Event {
+	t.Helper()
+	evt, err := eventv1.NewString(eventv1.EventKind_EVENT_KIND_HYPR_WINDOWTITLE, title)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return evt
+}
+
+func drain(q *Queue) []*eventv1.Event {
+	return q.popBatch(nil, q.Len()+1)
+}
+
+func TestQueueCoalesce(t *testing.T) {
+	q := New(4)
+	title := titleEvent(t, `a`)
+	q.Push(sinkEvent(t, `sink`, 1))
+	q.Push(title)
+	last := sinkEvent(t, `sink`, 2)
+	q.Push(last)
+
+	if got := q.Len(); got != 2 {
+		t.Fatalf("Len() = %d, want 2", got)
+	}
+	evts := drain(q)
+	if len(evts) != 2 || evts[0] != title || evts[1] != last {
+		t.Fatalf("got %v, want the title event followed by the latest sink event", evts)
+	}
+	if stats := q.TakeStats(); stats.Coalesced != 1 || stats.Dropped != 0 {
+		t.Fatalf("stats = %+v, want 1 coalesced and none dropped", stats)
+	}
+}
+
+func TestQueueCoalesceBounded(t *testing.T) {
+	q := New(4)
+	q.Push(titleEvent(t, `a`))
+	for i := range 1000 {
+		q.Push(sinkEvent(t, `sink`, int32(i)))
+		if len(q.entries) > q.size {
+			t.Fatalf("queue holds %d entries after %d pushes, want at most %d", len(q.entries), i+2, q.size)
+		}
+	}
+
+	evts := drain(q)
+	if len(evts) != 2 {
+		t.Fatalf("got %d events, want 2", len(evts))
+	}
+	v := &eventv1.AudioSinkChangeValue{}
+	if err := evts[1].Data.UnmarshalTo(v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Volume != 999 {
+		t.Fatalf("volume = %d, want 999", v.Volume)
+	}
+	if stats := q.TakeStats(); stats.Coalesced != 999 || stats.Dropped != 0 {
+		t.Fatalf("stats = %+v, want 999 coalesced and none dropped", stats)
+	}
+}
+
+func TestQueueDropOldest(t *testing.T) {
+	q := New(3)
+	evts := make([]*eventv1.Event, 5)
+	for i := range evts {
+		evts[i] = titleEvent(t, string(rune('a'+i)))
+		q.Push(evts[i])
+	}
+
+	if got := q.Len(); got != 3 {
+		t.Fatalf("Len() = %d, want 3", got)
+	}
+	got := drain(q)
+	for i, evt := range got {
+		if evt != evts[i+2] {
+			t.Fatalf("event %d is not the expected event, oldest events should be dropped", i)
+		}
+	}
+	if stats := q.TakeStats(); stats.Dropped != 2 {
+		t.Fatalf("stats = %+v, want 2 dropped", stats)
+	}
+}
+
+func TestQueueDropOldestCoalesced(t *testing.T) {
+	q := New(2)
+	q.Push(sinkEvent(t, `sink`, 1))
+	q.Push(titleEvent(t, `a`))
+	q.Push(titleEvent(t, `b`))
+	// The sink event was dropped, so a new one must not coalesce with it.
+	last := sinkEvent(t, `sink`, 2)
+	q.Push(last)
+
+	evts := drain(q)
+	if len(evts) != 2 || evts[1] != last {
+		t.Fatalf("got %v, want the latest title and sink events", evts)
+	}
+	if stats := q.TakeStats(); stats.Dropped != 2 || stats.Coalesced != 0 {
+		t.Fatalf("stats = %+v, want 2 dropped and none coalesced", stats)
+	}
+}
+
+func TestQueueClear(t *testing.T) {
+	q := New(4)
+	q.Push(sinkEvent(t, `sink`, 1))
+	q.Clear()
+	q.Push(sinkEvent(t, `sink`, 2))
+
+	if got := q.Len(); got != 1 {
+		t.Fatalf("Len() = %d, want 1", got)
+	}
+	if stats := q.TakeStats(); stats.Coalesced != 0 {
+		t.Fatalf("stats = %+v, want none coalesced after Clear", stats)
+	}
+}
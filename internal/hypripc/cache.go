@@ -0,0 +1,91 @@
+package hypripc
+
+import (
+	"sync"
+	"time"
+
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+)
+
+// cacheEntry holds the most recent response to a query, and the generation and
+// time it was requested in.
+type cacheEntry struct {
+	mu      sync.Mutex
+	valid   bool
+	gen     uint64
+	fetched time.Time
+	data    []byte
+}
+
+// Cache is a Conn that caches query responses until invalidated, so that
+// concurrent and repeated queries between Hyprland events share a single
+// request. Invalidate must be called for every Hyprland event, before the event
+// is delivered to anything that may query in response.
+//
+// Some state changes, such as windows being moved or resized, do not emit an
+// event, so responses also expire after maxAge. Clients polling for such
+// changes should do so no more often than maxAge.
+type Cache struct {
+	conn   Conn
+	maxAge time.Duration
+
+	mu      sync.Mutex
+	gen     uint64
+	entries map[hyprpanelv1.HyprQuery]*cacheEntry
+}
+
+// Query implementation. The returned slice is shared and must not be modified.
+func (c *Cache) Query(query hyprpanelv1.HyprQuery) ([]byte, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[query]
+	if !ok {
+		entry = &cacheEntry{}
+		c.entries[query] = entry
+	}
+	c.mu.Unlock()
+
+	// Holding the entry lock while requesting coalesces concurrent queries.
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	c.mu.Lock()
+	gen := c.gen
+	c.mu.Unlock()
+	if entry.valid && entry.gen == gen && time.Since(entry.fetched) < c.maxAge {
+		return entry.data, nil
+	}
+
+	fetched := time.Now()
+	data, err := c.conn.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	// A response requested before an invalidation is stored against the old
+	// generation, and is requested again on next use.
+	entry.valid, entry.gen, entry.fetched, entry.data = true, gen, fetched, data
+
+	return data, nil
+}
+
+// Dispatch implementation. Cached responses are invalidated, since dispatches
+// generally modify compositor state.
+func (c *Cache) Dispatch(args ...string) error {
+	defer c.Invalidate()
+	return c.conn.Dispatch(args...)
+}
+
+// Invalidate discards all cached responses.
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+}
+
+// NewCache instantiates a new Cache over conn, with responses expiring after
+// maxAge.
+func NewCache(conn Conn, maxAge time.Duration) *Cache {
+	return &Cache{
+		conn:    conn,
+		maxAge:  maxAge,
+		entries: make(map[hyprpanelv1.HyprQuery]*cacheEntry),
+	}
+}
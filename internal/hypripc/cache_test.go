@@ -0,0 +1,73 @@
+package hypripc
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+)
+
+// countingConn responds to each query with the number of queries made so far.
+type countingConn struct {
+	mu      sync.Mutex
+	queries int
+}
+
+func (c *countingConn) Query(hyprpanelv1.HyprQuery) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queries++
+
+	return []byte(strconv.Itoa(c.queries)), nil
+}
+
+func (c *countingConn) Dispatch(...string) error {
+	return nil
+}
+
+func query(t *testing.T, c *Cache) string {
+	t.Helper()
+	b, err := c.Query(hyprpanelv1.HyprQuery_HYPR_QUERY_CLIENTS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(b)
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	c := NewCache(&countingConn{}, time.Hour)
+
+	if got := query(t, c); got != `1` {
+		t.Fatalf("first query = %s, want 1", got)
+	}
+	if got := query(t, c); got != `1` {
+		t.Fatalf("repeated query = %s, want cached 1", got)
+	}
+	c.Invalidate()
+	if got := query(t, c); got != `2` {
+		t.Fatalf("query after Invalidate = %s, want 2", got)
+	}
+	if err := c.Dispatch(`workspace`, `1`); err != nil {
+		t.Fatal(err)
+	}
+	if got := query(t, c); got != `3` {
+		t.Fatalf("query after Dispatch = %s, want 3", got)
+	}
+}
+
+func TestCacheMaxAge(t *testing.T) {
+	const maxAge = 50 * time.Millisecond
+	c := NewCache(&countingConn{}, maxAge)
+
+	if got := query(t, c); got != `1` {
+		t.Fatalf("first query = %s, want 1", got)
+	}
+	time.Sleep(2 * maxAge)
+	// No event was received, but the response must not outlive maxAge.
+	if got := query(t, c); got != `2` {
+		t.Fatalf("query after maxAge = %s, want 2", got)
+	}
+}
@@ -0,0 +1,153 @@
+package hypripc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+)
+
+// Conn performs requests against the Hyprland control socket, either directly
+// or by proxy.
+type Conn interface {
+	// Query returns the JSON response to query.
+	Query(query hyprpanelv1.HyprQuery) ([]byte, error)
+	// Dispatch calls a dispatcher.
+	Dispatch(args ...string) error
+}
+
+// Control provides decoded Hyprland queries and dispatches over a Conn.
+type Control struct {
+	conn Conn
+}
+
+// ActiveWindow returns the currently active window client.
+func (c *Control) ActiveWindow() (*Client, error) {
+	client := &Client{}
+	if err := c.query(hyprpanelv1.HyprQuery_HYPR_QUERY_ACTIVE_WINDOW, client); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// ActiveWorkspace returns the currently actie workspace.
+func (c *Control) ActiveWorkspace() (*Workspace, error) {
+	workspace := &Workspace{}
+	if err := c.query(hyprpanelv1.HyprQuery_HYPR_QUERY_ACTIVE_WORKSPACE, workspace); err != nil {
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+// Clients returns a list of all active client windows,
+func (c *Control) Clients() ([]Client, error) {
+	clients := make([]Client, 0)
+	if err := c.query(hyprpanelv1.HyprQuery_HYPR_QUERY_CLIENTS, &clients); err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
+
+// Monitors returns a list of active monitors.
+func (c *Control) Monitors() ([]Monitor, error) {
+	monitors := make([]Monitor, 0)
+	if err := c.query(hyprpanelv1.HyprQuery_HYPR_QUERY_MONITORS, &monitors); err != nil {
+		return nil, err
+	}
+
+	return monitors, nil
+}
+
+// Workspaces returns a list of all active workspaces.
+func (c *Control) Workspaces() ([]Workspace, error) {
+	workspaces := make([]Workspace, 0)
+	if err := c.query(hyprpanelv1.HyprQuery_HYPR_QUERY_WORKSPACES, &workspaces); err != nil {
+		return nil, err
+	}
+
+	return workspaces, nil
+}
+
+// Dispatch calls a dispatcher.
+func (c *Control) Dispatch(args ...string) error {
+	return c.conn.Dispatch(args...)
+}
+
+func (c *Control) query(query hyprpanelv1.HyprQuery, v any) error {
+	res, err := c.conn.Query(query)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(res, v)
+}
+
+// NewControl instantiates a new Control using conn.
+func NewControl(conn Conn) *Control {
+	return &Control{conn: conn}
+}
+
+// socketConn requests directly over the Hyprland control socket, using a new
+// connection for each request.
+type socketConn struct {
+	log hclog.Logger
+}
+
+// Query implementation.
+func (s *socketConn) Query(query hyprpanelv1.HyprQuery) ([]byte, error) {
+	switch query {
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_CLIENTS:
+		return s.send(`clients`)
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_MONITORS:
+		return s.send(`monitors`, `all`)
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_WORKSPACES:
+		return s.send(`workspaces`)
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_ACTIVE_WINDOW:
+		return s.send(`activewindow`)
+	case hyprpanelv1.HyprQuery_HYPR_QUERY_ACTIVE_WORKSPACE:
+		return s.send(`activeworkspace`)
+	default:
+		return nil, fmt.Errorf("unsupported query: %s", query)
+	}
+}
+
+// Dispatch implementation.
+func (s *socketConn) Dispatch(args ...string) error {
+	_, err := s.send(append([]string{`dispatch`}, args...)...)
+	return err
+}
+
+func (s *socketConn) send(args ...string) ([]byte, error) {
+	sock, err := socketPath(`.socket.sock`)
+	if err != nil {
+		return nil, err
+	}
+	ctrl, err := net.Dial(`unix`, sock)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := ctrl.Close(); err != nil {
+			s.log.Error(`failed closing hyprland IPC connection`, `err`, err)
+		}
+	}()
+
+	if _, err := fmt.Fprintf(ctrl, "j/%s", strings.Join(args, ` `)); err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(ctrl)
+}
+
+// NewSocketConn returns a Conn that requests directly over the Hyprland control
+// socket.
+func NewSocketConn(log hclog.Logger) Conn {
+	return &socketConn{log: log}
+}
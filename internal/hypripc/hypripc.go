@@ -3,9 +3,7 @@ package hypripc
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net"
 	"os"
 	"path"
@@ -108,6 +106,7 @@ type CancelFunc func()
 
 // HyprIPC client.
 type HyprIPC struct {
+	*Control
 	log           hclog.Logger
 	subscriptions map[Event]map[uuid.UUID]chan *eventv1.Event
 	evtConn       net.Conn
@@ -116,109 +115,6 @@ type HyprIPC struct {
 	mu            sync.RWMutex
 }
 
-// ActiveWindow returns the currently active window client.
-func (h *HyprIPC) ActiveWindow() (*Client, error) {
-	res, err := h.send(`activewindow`)
-	if err != nil {
-		return nil, err
-	}
-
-	client := &Client{}
-	if err := json.Unmarshal(res, client); err != nil {
-		return nil, err
-	}
-
-	return client, nil
-}
-
-// ActiveWorkspace returns the currently actie workspace.
-func (h *HyprIPC) ActiveWorkspace() (*Workspace, error) {
-	res, err := h.send(`activeworkspace`)
-	if err != nil {
-		return nil, err
-	}
-
-	workspace := &Workspace{}
-	if err := json.Unmarshal(res, workspace); err != nil {
-		return nil, err
-	}
-
-	return workspace, nil
-}
-
-// Clients returns a list of all active client windows,
-func (h *HyprIPC) Clients() ([]Client, error) {
-	res, err := h.send(`clients`)
-	if err != nil {
-		return nil, err
-	}
-
-	clients := make([]Client, 0)
-	if err := json.Unmarshal(res, &clients); err != nil {
-		return nil, err
-	}
-
-	return clients, nil
-}
-
-// Monitors returns a list of active monitors.
-func (h *HyprIPC) Monitors() ([]Monitor, error) {
-	res, err := h.send(`monitors all`)
-	if err != nil {
-		return nil, err
-	}
-
-	monitors := make([]Monitor, 0)
-	if err := json.Unmarshal(res, &monitors); err != nil {
-		return nil, err
-	}
-
-	return monitors, nil
-}
-
-// Workspaces returns a list of all active workspaces.
-func (h *HyprIPC) Workspaces() ([]Workspace, error) {
-	res, err := h.send(`workspaces`)
-	if err != nil {
-		return nil, err
-	}
-
-	workspaces := make([]Workspace, 0)
-	if err := json.Unmarshal(res, &workspaces); err != nil {
-		return nil, err
-	}
-
-	return workspaces, nil
-}
-
-// Dispatch calls a dispatcher.
-func (h *HyprIPC) Dispatch(args ...string) error {
-	_, err := h.send(append([]string{`dispatch`}, args...)...)
-	return err
-}
-
-func (h *HyprIPC) send(args ...string) ([]byte, error) {
-	sock, err := socketPath(`.socket.sock`)
-	if err != nil {
-		return nil, err
-	}
-	ctrl, err := net.Dial(`unix`, sock)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if err := ctrl.Close(); err != nil {
-			h.log.Error(`failed closing hyprland IPC connection`, `err`, err)
-		}
-	}()
-
-	if _, err := fmt.Fprintf(ctrl, "j/%s", strings.Join(args, ` `)); err != nil {
-		return nil, err
-	}
-
-	return io.ReadAll(ctrl)
-}
-
 // Subscribe returns a channel that will emit the specified event(s) when they arrive.
 func (h *HyprIPC) Subscribe(evt ...Event) (chan *eventv1.Event, CancelFunc) {
 	id := uuid.New()
@@ -339,6 +235,7 @@ func New(log hclog.Logger) (*HyprIPC, error) {
 	}
 
 	ipc := &HyprIPC{
+		Control:       NewControl(NewSocketConn(log)),
 		log:           log,
 		evtConn:       evtConn,
 		evtBus:        make(chan []byte, 10),
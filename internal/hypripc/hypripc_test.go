@@ -0,0 +1,175 @@
+package hypripc_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pdf/hyprpanel/internal/hypripc"
+	"github.com/pdf/hyprpanel/internal/hypripc/hypriptest"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+)
+
+const eventTimeout = 5 * time.Second
+
+func testState() hypriptest.State {
+	state := hypriptest.State{
+		Monitors: []hypripc.Monitor{
+			{ID: 0, Name: `DP-1`, Width: 2560, Height: 1440},
+			{ID: 1, Name: `DP-2`, Width: 1920, Height: 1080},
+		},
+		Workspaces: []hypripc.Workspace{
+			{ID: 1, Name: `1`, Monitor: `DP-1`, Windows: 1},
+			{ID: 2, Name: `2`, Monitor: `DP-2`},
+		},
+	}
+	client := hypripc.Client{Address: `0x1234`, Class: `foot`, Title: `shell`, Mapped: true}
+	client.Workspace.ID, client.Workspace.Name = 1, `1`
+	state.Clients = []hypripc.Client{client}
+	state.ActiveWindow = &state.Clients[0]
+	state.ActiveWorkspace = &state.Workspaces[0]
+
+	return state
+}
+
+func newTestIPC(t *testing.T) (*hypriptest.Server, *hypripc.HyprIPC) {
+	t.Helper()
+	srv, err := hypriptest.NewServer(testState())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := srv.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ipc, err := hypripc.New(hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(ipc.Close)
+
+	return srv, ipc
+}
+
+func receive(t *testing.T, ch <-chan *eventv1.Event) *eventv1.Event {
+	t.Helper()
+	select {
+	case evt := <-ch:
+		return evt
+	case <-time.After(eventTimeout):
+		t.Fatal(`timed out waiting for event`)
+		return nil
+	}
+}
+
+func TestQueries(t *testing.T) {
+	_, ipc := newTestIPC(t)
+
+	monitors, err := ipc.Monitors()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(monitors) != 2 || monitors[1].Name != `DP-2` || monitors[1].Width != 1920 {
+		t.Fatalf("Monitors() = %+v", monitors)
+	}
+
+	workspaces, err := ipc.Workspaces()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(workspaces) != 2 || workspaces[0].Windows != 1 {
+		t.Fatalf("Workspaces() = %+v", workspaces)
+	}
+
+	clients, err := ipc.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clients) != 1 || clients[0].Address != `0x1234` || clients[0].Workspace.ID != 1 {
+		t.Fatalf("Clients() = %+v", clients)
+	}
+
+	active, err := ipc.ActiveWindow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if active.Class != `foot` {
+		t.Fatalf("ActiveWindow() = %+v", active)
+	}
+
+	ws, err := ipc.ActiveWorkspace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ws.ID != 1 {
+		t.Fatalf("ActiveWorkspace() = %+v", ws)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	srv, ipc := newTestIPC(t)
+	ch, cancel := ipc.Subscribe(hypripc.EventWorkspaceV2)
+	defer cancel()
+	ipc.StartEvents()
+	if err := srv.WaitSubscribers(1, eventTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unsubscribed events must not be delivered.
+	if err := srv.Emit(hypripc.EventFocusedMon, `DP-2,2`); err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.Emit(hypripc.EventWorkspaceV2, `2,2`); err != nil {
+		t.Fatal(err)
+	}
+
+	evt := receive(t, ch)
+	if evt.Kind != eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACEV2 {
+		t.Fatalf("got event kind %s, want %s", evt.Kind, eventv1.EventKind_EVENT_KIND_HYPR_WORKSPACEV2)
+	}
+	v := &eventv1.HyprWorkspaceV2Value{}
+	if err := evt.Data.UnmarshalTo(v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Id != 2 || v.Name != `2` {
+		t.Fatalf("got workspace %d %q, want 2 %q", v.Id, v.Name, `2`)
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	srv, ipc := newTestIPC(t)
+	ch, cancel := ipc.Subscribe(hypripc.EventMoveWindowV2)
+	defer cancel()
+	ipc.StartEvents()
+	if err := srv.WaitSubscribers(1, eventTimeout); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ipc.Dispatch(hypripc.DispatchMoveToWorkspaceSilent, `2,address:0x1234`); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{hypripc.DispatchMoveToWorkspaceSilent, `2,address:0x1234`}
+	if dispatched := srv.Dispatched(); len(dispatched) != 1 || !slices.Equal(dispatched[0], want) {
+		t.Fatalf("Dispatched() = %v, want [%v]", dispatched, want)
+	}
+
+	evt := receive(t, ch)
+	v := &eventv1.HyprMoveWindowV2Value{}
+	if err := evt.Data.UnmarshalTo(v); err != nil {
+		t.Fatal(err)
+	}
+	if v.WorkspaceId != 2 {
+		t.Fatalf("got workspace %d, want 2", v.WorkspaceId)
+	}
+
+	clients, err := ipc.Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clients[0].Workspace.ID != 2 {
+		t.Fatalf("client workspace = %d, want 2", clients[0].Workspace.ID)
+	}
+}
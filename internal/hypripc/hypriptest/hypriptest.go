@@ -0,0 +1,494 @@
+// Package hypriptest provides an in-process fake Hyprland IPC server, for
+// testing code that depends on hypripc without a running compositor.
+//
+// A Server listens on both the request (.socket.sock) and event
+// (.socket2.sock) sockets in a temporary directory, and points the
+// XDG_RUNTIME_DIR and HYPRLAND_INSTANCE_SIGNATURE environment variables at it
+// until closed. Since the environment is process-wide, servers must not be
+// used from parallel tests.
+package hypriptest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pdf/hyprpanel/internal/hypripc"
+)
+
+const (
+	requestSocket = `.socket.sock`
+	eventSocket   = `.socket2.sock`
+	signature     = `hypriptest`
+	readSize      = 8192
+	responseOK    = `ok`
+)
+
+// ErrUnknownDispatcher is returned for dispatch requests with no registered handler.
+var ErrUnknownDispatcher = errors.New(`unknown dispatcher`)
+
+// State holds the compositor state served by a Server.
+type State struct {
+	Clients         []hypripc.Client
+	Monitors        []hypripc.Monitor
+	Workspaces      []hypripc.Workspace
+	ActiveWindow    *hypripc.Client
+	ActiveWorkspace *hypripc.Workspace
+}
+
+// DispatchFunc handles a dispatch request. It is called without the server
+// lock held, so may call Update and Emit on srv.
+type DispatchFunc func(srv *Server, args []string) error
+
+// Server is a fake Hyprland IPC server.
+type Server struct {
+	dir         string
+	prevEnv     map[string]*string
+	requests    net.Listener
+	events      net.Listener
+	wg          sync.WaitGroup
+	closeOnce   sync.Once
+	dispatchers map[string]DispatchFunc
+
+	mu         sync.Mutex
+	state      State
+	dispatched [][]string
+	eventConns map[net.Conn]struct{}
+	eventCond  *sync.Cond
+}
+
+// SetState replaces the served compositor state.
+func (s *Server) SetState(state State) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+}
+
+// Update calls fn with the served compositor state for modification.
+func (s *Server) Update(fn func(state *State)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.state)
+}
+
+// State returns a copy of the served compositor state.
+func (s *Server) State() State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := s.state
+	state.Clients = slices.Clone(s.state.Clients)
+	state.Monitors = slices.Clone(s.state.Monitors)
+	state.Workspaces = slices.Clone(s.state.Workspaces)
+	return state
+}
+
+// Handle registers fn as the handler for the named dispatcher, replacing any
+// existing handler.
+func (s *Server) Handle(dispatcher string, fn DispatchFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatchers[dispatcher] = fn
+}
+
+// Dispatched returns the arguments of every dispatch request received, in
+// order, with the dispatcher name as the first element.
+func (s *Server) Dispatched() [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.dispatched)
+}
+
+// Emit writes an `event>>value` line to all connected event subscribers.
+func (s *Server) Emit(event, value string) error {
+	line := []byte(event + `>>` + value + "\n")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var errs []error
+	for conn := range s.eventConns {
+		if _, err := conn.Write(line); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// WaitSubscribers blocks until at least n event subscribers are connected, or
+// timeout elapses.
+func (s *Server) WaitSubscribers(n int, timeout time.Duration) error {
+	timer := time.AfterFunc(timeout, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.eventCond.Broadcast()
+	})
+	defer timer.Stop()
+
+	deadline := time.Now().Add(timeout)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.eventConns) < n {
+		if !time.Now().Before(deadline) {
+			return fmt.Errorf("timed out waiting for %d event subscribers, have %d", n, len(s.eventConns))
+		}
+		s.eventCond.Wait()
+	}
+
+	return nil
+}
+
+// Close stops the server, removes its sockets, and restores the environment.
+func (s *Server) Close() error {
+	var errs []error
+	s.closeOnce.Do(func() {
+		errs = append(errs, s.requests.Close(), s.events.Close())
+
+		s.mu.Lock()
+		for conn := range s.eventConns {
+			errs = append(errs, conn.Close())
+		}
+		s.mu.Unlock()
+		s.wg.Wait()
+
+		for k, v := range s.prevEnv {
+			if v == nil {
+				errs = append(errs, os.Unsetenv(k))
+			} else {
+				errs = append(errs, os.Setenv(k, *v))
+			}
+		}
+		errs = append(errs, os.RemoveAll(s.dir))
+	})
+
+	return errors.Join(errs...)
+}
+
+func (s *Server) serveRequests() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.requests.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go s.handleRequest(conn)
+	}
+}
+
+func (s *Server) handleRequest(conn net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	buf := make([]byte, readSize)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return
+	}
+
+	_, _ = conn.Write(s.respond(string(buf[:n])))
+}
+
+// respond returns the response to a request of the form `[flags]/command args`.
+func (s *Server) respond(req string) []byte {
+	if _, cmd, ok := strings.Cut(req, `/`); ok {
+		req = cmd
+	}
+	fields := strings.Fields(req)
+	if len(fields) == 0 {
+		return []byte(`unknown request`)
+	}
+
+	s.mu.Lock()
+	var v any
+	switch fields[0] {
+	case `clients`:
+		v = s.state.Clients
+	case `monitors`:
+		v = s.state.Monitors
+	case `workspaces`:
+		v = s.state.Workspaces
+	case `activewindow`:
+		v = s.state.ActiveWindow
+	case `activeworkspace`:
+		v = s.state.ActiveWorkspace
+	case `dispatch`:
+		if len(fields) < 2 {
+			s.mu.Unlock()
+			return []byte(`dispatch requires a dispatcher`)
+		}
+		s.dispatched = append(s.dispatched, fields[1:])
+		fn, ok := s.dispatchers[fields[1]]
+		s.mu.Unlock()
+		if !ok {
+			return []byte(fmt.Sprintf("%s: %s", ErrUnknownDispatcher, fields[1]))
+		}
+		if err := fn(s, fields[2:]); err != nil {
+			return []byte(err.Error())
+		}
+		return []byte(responseOK)
+	default:
+		s.mu.Unlock()
+		return []byte(`unknown request`)
+	}
+
+	b, err := json.Marshal(v)
+	s.mu.Unlock()
+	if err != nil {
+		return []byte(err.Error())
+	}
+	// Hyprland responds with an empty object when nothing is active.
+	if string(b) == `null` {
+		return []byte(`{}`)
+	}
+
+	return b
+}
+
+func (s *Server) serveEvents() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.events.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.eventConns[conn] = struct{}{}
+		s.eventCond.Broadcast()
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go s.watchEventConn(conn)
+	}
+}
+
+// watchEventConn discards any input, and forgets the connection when the
+// subscriber disconnects.
+func (s *Server) watchEventConn(conn net.Conn) {
+	defer s.wg.Done()
+	buf := make([]byte, readSize)
+	for {
+		if _, err := conn.Read(buf); err != nil {
+			break
+		}
+	}
+
+	s.mu.Lock()
+	delete(s.eventConns, conn)
+	s.mu.Unlock()
+	_ = conn.Close()
+}
+
+// NewServer starts a fake Hyprland IPC server with the supplied initial state,
+// and the default dispatchers registered.
+func NewServer(state State) (*Server, error) {
+	dir, err := os.MkdirTemp(``, `hypriptest`)
+	if err != nil {
+		return nil, err
+	}
+	sockDir := filepath.Join(dir, `hypr`, signature)
+	if err := os.MkdirAll(sockDir, 0o700); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	requests, err := net.Listen(`unix`, filepath.Join(sockDir, requestSocket))
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+	events, err := net.Listen(`unix`, filepath.Join(sockDir, eventSocket))
+	if err != nil {
+		_ = requests.Close()
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	s := &Server{
+		dir:         dir,
+		prevEnv:     make(map[string]*string),
+		requests:    requests,
+		events:      events,
+		dispatchers: make(map[string]DispatchFunc),
+		state:       state,
+		eventConns:  make(map[net.Conn]struct{}),
+	}
+	s.eventCond = sync.NewCond(&s.mu)
+	s.dispatchers[hypripc.DispatchWorkspace] = dispatchWorkspace
+	s.dispatchers[hypripc.DispatchFocusWindow] = dispatchFocusWindow
+	s.dispatchers[hypripc.DispatchCloseWindow] = dispatchCloseWindow
+	s.dispatchers[hypripc.DispatchMoveToWorkspace] = dispatchMoveToWorkspace
+	s.dispatchers[hypripc.DispatchMoveToWorkspaceSilent] = dispatchMoveToWorkspace
+
+	for k, v := range map[string]string{
+		`XDG_RUNTIME_DIR`:             dir,
+		`HYPRLAND_INSTANCE_SIGNATURE`: signature,
+	} {
+		if prev, ok := os.LookupEnv(k); ok {
+			s.prevEnv[k] = &prev
+		} else {
+			s.prevEnv[k] = nil
+		}
+		if err := os.Setenv(k, v); err != nil {
+			_ = s.Close()
+			return nil, err
+		}
+	}
+
+	s.wg.Add(2)
+	go s.serveRequests()
+	go s.serveEvents()
+
+	return s, nil
+}
+
+// dispatchWorkspace activates the workspace with the specified ID or name.
+func dispatchWorkspace(srv *Server, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("invalid workspace arguments: %v", args)
+	}
+
+	var (
+		ws    hypripc.Workspace
+		found bool
+	)
+	srv.Update(func(state *State) {
+		id, err := strconv.Atoi(args[0])
+		for _, w := range state.Workspaces {
+			if (err == nil && w.ID == id) || w.Name == args[0] {
+				ws, found = w, true
+				state.ActiveWorkspace = &w
+				break
+			}
+		}
+	})
+	if !found {
+		return fmt.Errorf("workspace not found: %s", args[0])
+	}
+
+	return errors.Join(
+		srv.Emit(hypripc.EventWorkspace, ws.Name),
+		srv.Emit(hypripc.EventWorkspaceV2, fmt.Sprintf("%d,%s", ws.ID, ws.Name)),
+	)
+}
+
+// dispatchFocusWindow activates the client matching `address:ADDR`.
+func dispatchFocusWindow(srv *Server, args []string) error {
+	addr, err := addressArg(args)
+	if err != nil {
+		return err
+	}
+
+	var (
+		client hypripc.Client
+		found  bool
+	)
+	srv.Update(func(state *State) {
+		if i := findClient(state.Clients, addr); i >= 0 {
+			client, found = state.Clients[i], true
+			state.ActiveWindow = &client
+		}
+	})
+	if !found {
+		return fmt.Errorf("client not found: %s", addr)
+	}
+
+	return errors.Join(
+		srv.Emit(hypripc.EventActiveWindow, client.Class+`,`+client.Title),
+		srv.Emit(hypripc.EventActiveWindowV2, strings.TrimPrefix(client.Address, `0x`)),
+	)
+}
+
+// dispatchCloseWindow removes the client matching `address:ADDR`.
+func dispatchCloseWindow(srv *Server, args []string) error {
+	addr, err := addressArg(args)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	srv.Update(func(state *State) {
+		if i := findClient(state.Clients, addr); i >= 0 {
+			found = true
+			state.Clients = slices.Delete(state.Clients, i, i+1)
+			if state.ActiveWindow != nil && state.ActiveWindow.Address == addr {
+				state.ActiveWindow = nil
+			}
+		}
+	})
+	if !found {
+		return fmt.Errorf("client not found: %s", addr)
+	}
+
+	return srv.Emit(hypripc.EventCloseWindow, strings.TrimPrefix(addr, `0x`))
+}
+
+// dispatchMoveToWorkspace moves the client matching `ID,address:ADDR` to the
+// workspace with the specified ID.
+func dispatchMoveToWorkspace(srv *Server, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("invalid movetoworkspace arguments: %v", args)
+	}
+	target, window, ok := strings.Cut(args[0], `,`)
+	if !ok {
+		return fmt.Errorf("invalid movetoworkspace arguments: %v", args)
+	}
+	id, err := strconv.Atoi(target)
+	if err != nil {
+		return fmt.Errorf("invalid workspace ID: %w", err)
+	}
+	addr, err := addressArg([]string{window})
+	if err != nil {
+		return err
+	}
+
+	var (
+		ws    hypripc.Workspace
+		found bool
+	)
+	srv.Update(func(state *State) {
+		i := findClient(state.Clients, addr)
+		if i < 0 {
+			return
+		}
+		for _, w := range state.Workspaces {
+			if w.ID == id {
+				ws, found = w, true
+				state.Clients[i].Workspace.ID = w.ID
+				state.Clients[i].Workspace.Name = w.Name
+				break
+			}
+		}
+	})
+	if !found {
+		return fmt.Errorf("client %s or workspace %d not found", addr, id)
+	}
+
+	return errors.Join(
+		srv.Emit(hypripc.EventMoveWindow, fmt.Sprintf("%s,%s", strings.TrimPrefix(addr, `0x`), ws.Name)),
+		srv.Emit(hypripc.EventMoveWindowV2, fmt.Sprintf("%s,%d,%s", strings.TrimPrefix(addr, `0x`), ws.ID, ws.Name)),
+	)
+}
+
+func addressArg(args []string) (string, error) {
+	if len(args) != 1 || !strings.HasPrefix(args[0], `address:`) {
+		return ``, fmt.Errorf("invalid window arguments: %v", args)
+	}
+
+	return strings.TrimPrefix(args[0], `address:`), nil
+}
+
+func findClient(clients []hypripc.Client, addr string) int {
+	return slices.IndexFunc(clients, func(c hypripc.Client) bool {
+		return c.Address == addr
+	})
+}
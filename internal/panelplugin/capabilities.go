@@ -0,0 +1,183 @@
+package panelplugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"slices"
+
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	modulev1 "github.com/pdf/hyprpanel/proto/hyprpanel/module/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	"google.golang.org/grpc"
+)
+
+const (
+	unknownVersion = `unknown`
+)
+
+// ErrUnsupported is returned when calling an RPC that the peer does not
+// support.
+var ErrUnsupported = errors.New(`unsupported by peer`)
+
+// Version returns the build version of the running binary.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok || info.Main.Version == `` {
+		return unknownVersion
+	}
+
+	return info.Main.Version
+}
+
+// HostCapabilities returns the capabilities of this host implementation.
+func HostCapabilities() *hyprpanelv1.Capabilities {
+	return &hyprpanelv1.Capabilities{
+		Version:    Version(),
+		Methods:    serviceMethods(hyprpanelv1.HostService_ServiceDesc),
+		EventKinds: eventKinds(),
+	}
+}
+
+// PanelCapabilities returns the capabilities of this panel implementation.
+func PanelCapabilities() *hyprpanelv1.Capabilities {
+	fields := (&modulev1.Module{}).ProtoReflect().Descriptor().Oneofs().ByName(`kind`).Fields()
+	modules := make([]string, 0, fields.Len())
+	for i := range fields.Len() {
+		modules = append(modules, string(fields.Get(i).Name()))
+	}
+
+	return &hyprpanelv1.Capabilities{
+		Version:    Version(),
+		Methods:    serviceMethods(hyprpanelv1.PanelService_ServiceDesc),
+		EventKinds: eventKinds(),
+		Modules:    modules,
+	}
+}
+
+// ModuleName returns the capability name of the module kind configured in
+// cfg, or an empty string if the kind is unknown to this binary.
+func ModuleName(cfg *modulev1.Module) string {
+	field := cfg.ProtoReflect().WhichOneof(cfg.ProtoReflect().Descriptor().Oneofs().ByName(`kind`))
+	if field == nil {
+		return ``
+	}
+
+	return string(field.Name())
+}
+
+// SupportsEvents reports whether caps include all kinds. Peers that did not
+// exchange capabilities are assumed to support everything.
+func SupportsEvents(caps *hyprpanelv1.Capabilities, kinds ...eventv1.EventKind) bool {
+	if caps == nil {
+		return true
+	}
+	for _, kind := range kinds {
+		if !slices.Contains(caps.EventKinds, kind) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SupportsModule reports whether caps include the module name. Peers that did
+// not exchange capabilities are assumed to support everything.
+func SupportsModule(caps *hyprpanelv1.Capabilities, name string) bool {
+	return caps == nil || slices.Contains(caps.Modules, name)
+}
+
+// VersionSkew returns a description of the version difference between this
+// binary and the named peer, or an empty string if the versions match.
+func VersionSkew(name string, remote *hyprpanelv1.Capabilities) string {
+	if remote == nil {
+		return fmt.Sprintf("%s predates version %s, install matching versions of hyprpanel and hyprpanel-client", name, Version())
+	}
+	if remote.Version == Version() {
+		return ``
+	}
+
+	return fmt.Sprintf("%s version %s does not match version %s, install matching versions of hyprpanel and hyprpanel-client", name, remote.Version, Version())
+}
+
+func serviceMethods(desc grpc.ServiceDesc) []string {
+	methods := make([]string, 0, len(desc.Methods)+len(desc.Streams))
+	for _, m := range desc.Methods {
+		methods = append(methods, `/`+desc.ServiceName+`/`+m.MethodName)
+	}
+	for _, s := range desc.Streams {
+		methods = append(methods, `/`+desc.ServiceName+`/`+s.StreamName)
+	}
+
+	return methods
+}
+
+func eventKinds() []eventv1.EventKind {
+	values := eventv1.EventKind_EVENT_KIND_UNSPECIFIED.Descriptor().Values()
+	kinds := make([]eventv1.EventKind, 0, values.Len())
+	for i := range values.Len() {
+		kind := eventv1.EventKind(values.Get(i).Number())
+		if kind == eventv1.EventKind_EVENT_KIND_UNSPECIFIED {
+			continue
+		}
+		kinds = append(kinds, kind)
+	}
+
+	return kinds
+}
+
+// capabilityConn rejects calls to methods that the peer did not advertise,
+// rather than failing with an opaque Unimplemented status.
+type capabilityConn struct {
+	grpc.ClientConnInterface
+	name    string
+	version string
+	methods map[string]struct{}
+}
+
+func (c *capabilityConn) check(method string) error {
+	if _, ok := c.methods[method]; ok {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s is not supported by %s version %s, install matching versions of hyprpanel and hyprpanel-client", ErrUnsupported, method, c.name, c.version)
+}
+
+// Invoke implementation.
+func (c *capabilityConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	if err := c.check(method); err != nil {
+		return err
+	}
+
+	return c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream implementation.
+func (c *capabilityConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := c.check(method); err != nil {
+		return nil, err
+	}
+
+	return c.ClientConnInterface.NewStream(ctx, desc, method, opts...)
+}
+
+// newCapabilityConn wraps conn to check calls against the methods advertised
+// by the named peer. Connections to peers that did not exchange capabilities
+// are returned unchanged.
+func newCapabilityConn(conn grpc.ClientConnInterface, name string, caps *hyprpanelv1.Capabilities) grpc.ClientConnInterface {
+	if caps == nil {
+		return conn
+	}
+	methods := make(map[string]struct{}, len(caps.Methods))
+	for _, method := range caps.Methods {
+		methods[method] = struct{}{}
+	}
+
+	return &capabilityConn{
+		ClientConnInterface: conn,
+		name:                name,
+		version:             caps.Version,
+		methods:             methods,
+	}
+}
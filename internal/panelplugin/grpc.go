@@ -2,7 +2,12 @@ package panelplugin
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"math"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-plugin"
@@ -10,14 +15,41 @@ import (
 	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
 	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+const (
+	// notifyBatchMaxBytes limits the encoded size of events sent in a single
+	// stream message, well below the default gRPC message size limit.
+	notifyBatchMaxBytes = 1 << 20
+	// notifyStreamOpenTimeout limits the wait for a panel to acknowledge a new
+	// event stream.
+	notifyStreamOpenTimeout = 5 * time.Second
+)
+
+var errClosed = errors.New(`panel closed`)
+
 // PanelGRPCClient panel plugin client implementation.
 type PanelGRPCClient struct {
-	broker *plugin.GRPCBroker
-	client hyprpanelv1.PanelServiceClient
-	server *grpc.Server
-	ctx    context.Context
+	broker       *plugin.GRPCBroker
+	conn         *grpc.ClientConn
+	client       hyprpanelv1.PanelServiceClient
+	server       *grpc.Server
+	ctx          context.Context
+	eventFilter  *hyprpanelv1.EventFilter
+	capabilities *hyprpanelv1.Capabilities
+
+	streamMu sync.Mutex
+	stream   hyprpanelv1.PanelService_NotifyStreamClient
+	// unary is set when the panel does not support NotifyStream.
+	unary bool
+
+	// streamCancel cancels the context of the open or opening stream. It is
+	// held outside streamMu, so that Close may abort a blocked send.
+	streamCancel atomic.Pointer[context.CancelFunc]
+	closed       atomic.Bool
 }
 
 // Init implementation.
@@ -34,15 +66,26 @@ func (c *PanelGRPCClient) Init(h Host, id string, loglevel configv1.LogLevel, co
 	hostRef := c.broker.NextId()
 	go c.broker.AcceptAndServe(hostRef, serverFunc)
 
-	_, err := c.client.Init(context.Background(), &hyprpanelv1.PanelServiceInitRequest{
-		Host:       hostRef,
-		Id:         id,
-		LogLevel:   loglevel,
-		Config:     config,
-		Stylesheet: stylesheet,
+	resp, err := c.client.Init(context.Background(), &hyprpanelv1.PanelServiceInitRequest{
+		Host:         hostRef,
+		Id:           id,
+		LogLevel:     loglevel,
+		Config:       config,
+		Stylesheet:   stylesheet,
+		Capabilities: h.Capabilities(),
 	})
+	if err != nil {
+		return err
+	}
+	c.capabilities = resp.Capabilities
+	c.client = hyprpanelv1.NewPanelServiceClient(newCapabilityConn(c.conn, `hyprpanel-client`, c.capabilities))
+	c.eventFilter = resp.EventFilter
+	if c.eventFilter == nil && c.capabilities != nil {
+		// Never send events that the panel does not know about.
+		c.eventFilter = &hyprpanelv1.EventFilter{Kinds: c.capabilities.EventKinds}
+	}
 
-	return err
+	return nil
 }
 
 // Notify implementation.
@@ -50,6 +93,122 @@ func (c *PanelGRPCClient) Notify(evt *eventv1.Event) {
 	_, _ = c.client.Notify(context.Background(), &hyprpanelv1.PanelServiceNotifyRequest{Event: evt})
 }
 
+// EventFilter implementation.
+func (c *PanelGRPCClient) EventFilter() *hyprpanelv1.EventFilter {
+	return c.eventFilter
+}
+
+// Capabilities implementation.
+func (c *PanelGRPCClient) Capabilities() *hyprpanelv1.Capabilities {
+	return c.capabilities
+}
+
+// NotifyBatch implementation. Events are written to the panel's event stream,
+// falling back to unary Notify calls for panels that do not support it.
+func (c *PanelGRPCClient) NotifyBatch(evts []*eventv1.Event) {
+	c.streamMu.Lock()
+	defer c.streamMu.Unlock()
+
+	if !c.unary {
+		sent, err := c.sendBatch(evts)
+		if err == nil || c.closed.Load() {
+			return
+		}
+		evts = evts[sent:]
+	}
+
+	for _, evt := range evts {
+		if c.closed.Load() {
+			return
+		}
+		c.Notify(evt)
+	}
+}
+
+// sendBatch writes evts to the event stream, opening it if required, and
+// returns the number of events sent. Events are split across messages to bound
+// message size. On failure the stream is reset, to be reopened by the next
+// batch.
+func (c *PanelGRPCClient) sendBatch(evts []*eventv1.Event) (int, error) {
+	if c.stream == nil {
+		if err := c.openStream(); err != nil {
+			return 0, err
+		}
+	}
+
+	sent, start, size := 0, 0, 0
+	for i, evt := range evts {
+		evtSize := proto.Size(evt)
+		if i > start && size+evtSize > notifyBatchMaxBytes {
+			if err := c.stream.Send(&hyprpanelv1.PanelServiceNotifyStreamRequest{Events: evts[start:i]}); err != nil {
+				c.resetStream()
+				return sent, err
+			}
+			sent, start, size = i, i, 0
+		}
+		size += evtSize
+	}
+	if err := c.stream.Send(&hyprpanelv1.PanelServiceNotifyStreamRequest{Events: evts[start:]}); err != nil {
+		c.resetStream()
+		return sent, err
+	}
+
+	return len(evts), nil
+}
+
+// openStream opens the event stream, and waits for the panel to acknowledge
+// it. Panels that do not implement the stream are switched to unary delivery.
+func (c *PanelGRPCClient) openStream() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.streamCancel.Store(&cancel)
+	// Close may have missed the cancel func stored above.
+	if c.closed.Load() {
+		c.cancelStream()
+		return errClosed
+	}
+
+	timer := time.AfterFunc(notifyStreamOpenTimeout, cancel)
+	stream, err := c.client.NotifyStream(ctx)
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if !timer.Stop() && err != nil {
+		err = fmt.Errorf("timed out waiting for the panel to acknowledge the event stream: %w", err)
+	}
+	if err != nil {
+		c.cancelStream()
+		if errors.Is(err, ErrUnsupported) || status.Code(err) == codes.Unimplemented {
+			c.unary = true
+		}
+		return err
+	}
+
+	c.stream = stream
+
+	return nil
+}
+
+func (c *PanelGRPCClient) resetStream() {
+	if c.stream == nil {
+		return
+	}
+	_ = c.stream.CloseSend()
+	c.cancelStream()
+	c.stream = nil
+}
+
+func (c *PanelGRPCClient) cancelStream() {
+	if cancel := c.streamCancel.Swap(nil); cancel != nil {
+		(*cancel)()
+	}
+}
+
+// UpdateStyle implementation.
+func (c *PanelGRPCClient) UpdateStyle(stylesheet []byte) error {
+	_, err := c.client.UpdateStyle(context.Background(), &hyprpanelv1.PanelServiceUpdateStyleRequest{Stylesheet: stylesheet})
+	return err
+}
+
 // Context implementation.
 func (c *PanelGRPCClient) Context() context.Context {
 	return c.ctx
@@ -58,6 +217,10 @@ func (c *PanelGRPCClient) Context() context.Context {
 // Close implementation.
 func (c *PanelGRPCClient) Close() {
 	defer c.server.Stop()
+	// Cancel rather than reset the stream, since NotifyBatch may be blocked on
+	// it while holding streamMu.
+	c.closed.Store(true)
+	c.cancelStream()
 	_, _ = c.client.Close(context.Background(), &hyprpanelv1.PanelServiceCloseRequest{})
 }
 
@@ -78,12 +241,15 @@ func (s *PanelGRPCServer) Init(_ context.Context, req *hyprpanelv1.PanelServiceI
 		return &hyprpanelv1.PanelServiceInitResponse{}, err
 	}
 
-	host := &HostGRPCClient{client: hyprpanelv1.NewHostServiceClient(s.conn)}
+	host := newHostGRPCClient(s.conn, req.Capabilities)
 	if err := s.Impl.Init(host, req.Id, req.LogLevel, req.Config, req.Stylesheet); err != nil {
 		return &hyprpanelv1.PanelServiceInitResponse{}, err
 	}
 
-	return &hyprpanelv1.PanelServiceInitResponse{}, nil
+	return &hyprpanelv1.PanelServiceInitResponse{
+		EventFilter:  s.Impl.EventFilter(),
+		Capabilities: s.Impl.Capabilities(),
+	}, nil
 }
 
 // Notify implementation.
@@ -93,6 +259,34 @@ func (s *PanelGRPCServer) Notify(_ context.Context, req *hyprpanelv1.PanelServic
 	return &hyprpanelv1.PanelServiceNotifyResponse{}, nil
 }
 
+// NotifyStream implementation. The stream is acknowledged on open, so that
+// hosts can fall back to Notify when it is not implemented.
+func (s *PanelGRPCServer) NotifyStream(stream hyprpanelv1.PanelService_NotifyStreamServer) error {
+	if err := stream.Send(&hyprpanelv1.PanelServiceNotifyStreamResponse{}); err != nil {
+		return err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.Impl.NotifyBatch(req.Events)
+	}
+}
+
+// UpdateStyle implementation.
+func (s *PanelGRPCServer) UpdateStyle(_ context.Context, req *hyprpanelv1.PanelServiceUpdateStyleRequest) (*hyprpanelv1.PanelServiceUpdateStyleResponse, error) {
+	if err := s.Impl.UpdateStyle(req.Stylesheet); err != nil {
+		return &hyprpanelv1.PanelServiceUpdateStyleResponse{}, err
+	}
+
+	return &hyprpanelv1.PanelServiceUpdateStyleResponse{}, nil
+}
+
 // Close implmenetation.
 func (s *PanelGRPCServer) Close(_ context.Context, _ *hyprpanelv1.PanelServiceCloseRequest) (*hyprpanelv1.PanelServiceCloseResponse, error) {
 	s.Impl.Close()
@@ -105,7 +299,27 @@ func (s *PanelGRPCServer) Close(_ context.Context, _ *hyprpanelv1.PanelServiceCl
 
 // HostGRPCClient plugin host client implementation.
 type HostGRPCClient struct {
-	client hyprpanelv1.HostServiceClient
+	client       hyprpanelv1.HostServiceClient
+	capabilities *hyprpanelv1.Capabilities
+}
+
+// NewHostGRPCClient returns a host client for an established connection.
+func NewHostGRPCClient(conn *grpc.ClientConn) *HostGRPCClient {
+	return newHostGRPCClient(conn, nil)
+}
+
+// newHostGRPCClient returns a host client that rejects calls to RPCs not
+// advertised in caps.
+func newHostGRPCClient(conn grpc.ClientConnInterface, caps *hyprpanelv1.Capabilities) *HostGRPCClient {
+	return &HostGRPCClient{
+		client:       hyprpanelv1.NewHostServiceClient(newCapabilityConn(conn, `hyprpanel`, caps)),
+		capabilities: caps,
+	}
+}
+
+// Capabilities implementation.
+func (c *HostGRPCClient) Capabilities() *hyprpanelv1.Capabilities {
+	return c.capabilities
 }
 
 func (c *HostGRPCClient) IdleInhibitorInhibit(target eventv1.InhibitTarget) error {
@@ -189,6 +403,23 @@ func (c *HostGRPCClient) MediaPlayerSetPosition(trackId string, pos int64) error
 	return nil
 }
 
+// HyprQuery implementation.
+func (c *HostGRPCClient) HyprQuery(query hyprpanelv1.HyprQuery) ([]byte, error) {
+	resp, err := c.client.HyprQuery(context.Background(), &hyprpanelv1.HostServiceHyprQueryRequest{Query: query})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Json, nil
+}
+
+// HyprDispatch implementation.
+func (c *HostGRPCClient) HyprDispatch(args ...string) error {
+	if _, err := c.client.HyprDispatch(context.Background(), &hyprpanelv1.HostServiceHyprDispatchRequest{Args: args}); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Exec implmenetation.
 func (c *HostGRPCClient) Exec(action *hyprpanelv1.AppInfo_Action) error {
 	_, err := c.client.Exec(context.Background(), &hyprpanelv1.HostServiceExecRequest{
@@ -430,6 +661,23 @@ func (s *HostGRPCServer) MediaPlayerSetPosition(ctx context.Context, req *hyprpa
 	return &hyprpanelv1.HostServiceMediaPlayerResponse{}, nil
 }
 
+// HyprQuery implementation.
+func (s *HostGRPCServer) HyprQuery(_ context.Context, req *hyprpanelv1.HostServiceHyprQueryRequest) (*hyprpanelv1.HostServiceHyprQueryResponse, error) {
+	res, err := s.Impl.HyprQuery(req.Query)
+	if err != nil {
+		return &hyprpanelv1.HostServiceHyprQueryResponse{}, err
+	}
+	return &hyprpanelv1.HostServiceHyprQueryResponse{Json: res}, nil
+}
+
+// HyprDispatch implementation.
+func (s *HostGRPCServer) HyprDispatch(_ context.Context, req *hyprpanelv1.HostServiceHyprDispatchRequest) (*hyprpanelv1.HostServiceHyprDispatchResponse, error) {
+	if err := s.Impl.HyprDispatch(req.Args...); err != nil {
+		return &hyprpanelv1.HostServiceHyprDispatchResponse{}, err
+	}
+	return &hyprpanelv1.HostServiceHyprDispatchResponse{}, nil
+}
+
 // Exec implementation.
 func (s *HostGRPCServer) Exec(_ context.Context, req *hyprpanelv1.HostServiceExecRequest) (*hyprpanelv1.HostServiceExecResponse, error) {
 	err := s.Impl.Exec(req.Action)
@@ -12,7 +12,11 @@ import (
 )
 
 // Compile-time check
-var _ plugin.GRPCPlugin = &PanelPlugin{}
+var (
+	_ plugin.GRPCPlugin = &PanelPlugin{}
+	_ Panel             = &PanelGRPCClient{}
+	_ Host              = &HostGRPCClient{}
+)
 
 const (
 	// PanelPluginName constant.
@@ -59,12 +63,26 @@ type Host interface {
 	MediaPlayerPrevious() error
 	MediaPlayerSeek(offset int64) error
 	MediaPlayerSetPosition(trackId string, pos int64) error
+	// HyprQuery returns the JSON response to a Hyprland query.
+	HyprQuery(query hyprpanelv1.HyprQuery) ([]byte, error)
+	HyprDispatch(args ...string) error
+	// Capabilities returns the version and supported RPCs and events of the
+	// host, or nil if unknown.
+	Capabilities() *hyprpanelv1.Capabilities
 }
 
 // Panel interface.
 type Panel interface {
 	Init(host Host, id string, loglevel configv1.LogLevel, config *configv1.Panel, stylesheet []byte) error
 	Notify(evt *eventv1.Event)
+	NotifyBatch(evts []*eventv1.Event)
+	// EventFilter returns the events the panel subscribes to once initialized,
+	// or nil to receive all events.
+	EventFilter() *hyprpanelv1.EventFilter
+	// Capabilities returns the version and supported RPCs, events and modules
+	// of the panel, or nil if unknown.
+	Capabilities() *hyprpanelv1.Capabilities
+	UpdateStyle(stylesheet []byte) error
 	Context() context.Context
 	Close()
 }
@@ -88,6 +106,7 @@ func (p *PanelPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) erro
 // GRPCClient satsifise the plugin interface.
 func (p *PanelPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
 	return &PanelGRPCClient{
+		conn:   c,
 		client: hyprpanelv1.NewPanelServiceClient(c),
 		broker: broker,
 		ctx:    ctx,
@@ -143,11 +143,12 @@ type Panel struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                    // unique identifier for this panel.
-	Edge    Edge         `protobuf:"varint,2,opt,name=edge,proto3,enum=hyprpanel.config.v1.Edge" json:"edge,omitempty"` // screen edge to place this panel.
-	Size    uint32       `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                               // either width or height in pixels, depending on orientation for screen edge.
-	Monitor string       `protobuf:"bytes,4,opt,name=monitor,proto3" json:"monitor,omitempty"`                          // monitor to display this panel on.
-	Modules []*v1.Module `protobuf:"bytes,5,rep,name=modules,proto3" json:"modules,omitempty"`                          // list of modules for this panel.
+	Id         string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                    // unique identifier for this panel.
+	Edge       Edge         `protobuf:"varint,2,opt,name=edge,proto3,enum=hyprpanel.config.v1.Edge" json:"edge,omitempty"` // screen edge to place this panel.
+	Size       uint32       `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                               // either width or height in pixels, depending on orientation for screen edge.
+	Monitor    string       `protobuf:"bytes,4,opt,name=monitor,proto3" json:"monitor,omitempty"`                          // monitor to display this panel on, either a name, a glob pattern (e.g. `*` for all monitors, `DP-*`), or a comma-separated list of these. Patterns and lists clone the panel onto every matching monitor. Empty displays on the first monitor.
+	Modules    []*v1.Module `protobuf:"bytes,5,rep,name=modules,proto3" json:"modules,omitempty"`                          // list of modules for this panel.
+	Stylesheet string       `protobuf:"bytes,6,opt,name=stylesheet,proto3" json:"stylesheet,omitempty"`                    // stylesheet applied to this panel only, following the main stylesheet. Relative paths are resolved against the directory of the main stylesheet.
 }
 
 func (x *Panel) Reset() {
@@ -217,6 +218,210 @@ func (x *Panel) GetModules() []*v1.Module {
 	return nil
 }
 
+func (x *Panel) GetStylesheet() string {
+	if x != nil {
+		return x.Stylesheet
+	}
+	return ""
+}
+
+type MonitorMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // optional identifier for the matched monitor, usable as a monitor selector by panels in the same profile (e.g. "primary").
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // glob pattern matched against the monitor name (e.g. "eDP-*"), empty matches any.
+	Make   string `protobuf:"bytes,3,opt,name=make,proto3" json:"make,omitempty"`     // glob pattern matched against the monitor make, empty matches any.
+	Model  string `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`   // glob pattern matched against the monitor model, empty matches any.
+	Serial string `protobuf:"bytes,5,opt,name=serial,proto3" json:"serial,omitempty"` // glob pattern matched against the monitor serial number, empty matches any.
+}
+
+func (x *MonitorMatch) Reset() {
+	*x = MonitorMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonitorMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorMatch) ProtoMessage() {}
+
+func (x *MonitorMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorMatch.ProtoReflect.Descriptor instead.
+func (*MonitorMatch) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MonitorMatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MonitorMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MonitorMatch) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *MonitorMatch) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *MonitorMatch) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // unique name for this profile.
+	Monitors []*MonitorMatch `protobuf:"bytes,2,rep,name=monitors,proto3" json:"monitors,omitempty"` // rules that must each match a different connected monitor for this profile to be selected. A profile without rules always matches.
+	Exact    bool            `protobuf:"varint,3,opt,name=exact,proto3" json:"exact,omitempty"`      // only select this profile if every connected monitor is matched by a rule.
+	Panels   []*Panel        `protobuf:"bytes,4,rep,name=panels,proto3" json:"panels,omitempty"`     // panels to display while this profile is selected, replacing the top-level panels.
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetMonitors() []*MonitorMatch {
+	if x != nil {
+		return x.Monitors
+	}
+	return nil
+}
+
+func (x *Profile) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *Profile) GetPanels() []*Panel {
+	if x != nil {
+		return x.Panels
+	}
+	return nil
+}
+
+type Palette struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Colors map[string]string `protobuf:"bytes,1,rep,name=colors,proto3" json:"colors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // GTK CSS colours by name (e.g. {"Highlight": "#6b52a6"}), defined with @define-color ahead of the stylesheet, and referenced as @Highlight.
+}
+
+func (x *Palette) Reset() {
+	*x = Palette{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Palette) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Palette) ProtoMessage() {}
+
+func (x *Palette) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Palette.ProtoReflect.Descriptor instead.
+func (*Palette) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Palette) GetColors() map[string]string {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
 type IconOverride struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -229,7 +434,7 @@ type IconOverride struct {
 func (x *IconOverride) Reset() {
 	*x = IconOverride{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[1]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -242,7 +447,7 @@ func (x *IconOverride) String() string {
 func (*IconOverride) ProtoMessage() {}
 
 func (x *IconOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[1]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +460,7 @@ func (x *IconOverride) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IconOverride.ProtoReflect.Descriptor instead.
 func (*IconOverride) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{1}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IconOverride) GetWindowClass() string {
@@ -285,12 +490,17 @@ type Config struct {
 	Panels                   []*Panel        `protobuf:"bytes,6,rep,name=panels,proto3" json:"panels,omitempty"`                                                                          // list of panels to display.
 	IconOverrides            []*IconOverride `protobuf:"bytes,7,rep,name=icon_overrides,json=iconOverrides,proto3" json:"icon_overrides,omitempty"`                                       // list of icon overrides.
 	LaunchWrapper            []string        `protobuf:"bytes,8,rep,name=launch_wrapper,json=launchWrapper,proto3" json:"launch_wrapper,omitempty"`                                       // command to wrap application launches with (e.g. ["uwsm", "app", "--"]).
+	Include                  []string        `protobuf:"bytes,9,rep,name=include,proto3" json:"include,omitempty"`                                                                        // configuration files to merge beneath this one, in order, with paths relative to this file. Fields set in this file override included values, and repeated fields are appended to included values unless listed in replace.
+	Replace                  []string        `protobuf:"bytes,10,rep,name=replace,proto3" json:"replace,omitempty"`                                                                       // repeated fields, by path (e.g. "panels", "launch_wrapper"), whose values in this file replace those from included files instead of being appended.
+	Version                  uint32          `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`                                                                      // configuration schema version. Older configuration files are migrated on startup, JSON files are rewritten with the original kept as a backup. Includes and overlays without a version are assumed current.
+	Profiles                 []*Profile      `protobuf:"bytes,12,rep,name=profiles,proto3" json:"profiles,omitempty"`                                                                     // layout profiles, the first whose rules match the connected monitors is selected, and re-evaluated when monitors are added or removed. The top-level panels are used if no profile matches.
+	Theme                    *Config_Theme   `protobuf:"bytes,13,opt,name=theme,proto3" json:"theme,omitempty"`                                                                           // colour palettes, defined as GTK CSS named colours for use in stylesheets.
 }
 
 func (x *Config) Reset() {
 	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[2]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -303,7 +513,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[2]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +526,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Config) GetLogLevel() LogLevel {
@@ -369,6 +579,41 @@ func (x *Config) GetLaunchWrapper() []string {
 	return nil
 }
 
+func (x *Config) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *Config) GetReplace() []string {
+	if x != nil {
+		return x.Replace
+	}
+	return nil
+}
+
+func (x *Config) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Config) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *Config) GetTheme() *Config_Theme {
+	if x != nil {
+		return x.Theme
+	}
+	return nil
+}
+
 type Config_DBUS struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -384,12 +629,13 @@ type Config_DBUS struct {
 	Power           *Config_DBUS_Power         `protobuf:"bytes,8,opt,name=power,proto3" json:"power,omitempty"`                                            // power configuration.
 	IdleInhibitor   *Config_DBUS_IdleInhibitor `protobuf:"bytes,9,opt,name=idle_inhibitor,json=idleInhibitor,proto3" json:"idle_inhibitor,omitempty"`       // idle inhibitor configuration.
 	MediaPlayer     *Config_DBUS_MediaPlayer   `protobuf:"bytes,10,opt,name=media_player,json=mediaPlayer,proto3" json:"media_player,omitempty"`            // media player configuration.
+	Appearance      *Config_DBUS_Appearance    `protobuf:"bytes,11,opt,name=appearance,proto3" json:"appearance,omitempty"`                                 // appearance configuration.
 }
 
 func (x *Config_DBUS) Reset() {
 	*x = Config_DBUS{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[3]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -402,7 +648,7 @@ func (x *Config_DBUS) String() string {
 func (*Config_DBUS) ProtoMessage() {}
 
 func (x *Config_DBUS) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[3]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +661,7 @@ func (x *Config_DBUS) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config_DBUS.ProtoReflect.Descriptor instead.
 func (*Config_DBUS) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2, 0}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *Config_DBUS) GetEnabled() bool {
@@ -488,6 +734,84 @@ func (x *Config_DBUS) GetMediaPlayer() *Config_DBUS_MediaPlayer {
 	return nil
 }
 
+func (x *Config_DBUS) GetAppearance() *Config_DBUS_Appearance {
+	if x != nil {
+		return x.Appearance
+	}
+	return nil
+}
+
+type Config_Theme struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Palettes map[string]*Palette `protobuf:"bytes,1,rep,name=palettes,proto3" json:"palettes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // named colour palettes.
+	Palette  string              `protobuf:"bytes,2,opt,name=palette,proto3" json:"palette,omitempty"`                                                                                           // palette applied when the desktop has no colour scheme preference, or it is not followed.
+	Dark     string              `protobuf:"bytes,3,opt,name=dark,proto3" json:"dark,omitempty"`                                                                                                 // palette applied when the desktop prefers a dark colour scheme, defaults to palette.
+	Light    string              `protobuf:"bytes,4,opt,name=light,proto3" json:"light,omitempty"`                                                                                               // palette applied when the desktop prefers a light colour scheme, defaults to palette.
+}
+
+func (x *Config_Theme) Reset() {
+	*x = Config_Theme{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_Theme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_Theme) ProtoMessage() {}
+
+func (x *Config_Theme) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_Theme.ProtoReflect.Descriptor instead.
+func (*Config_Theme) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *Config_Theme) GetPalettes() map[string]*Palette {
+	if x != nil {
+		return x.Palettes
+	}
+	return nil
+}
+
+func (x *Config_Theme) GetPalette() string {
+	if x != nil {
+		return x.Palette
+	}
+	return ""
+}
+
+func (x *Config_Theme) GetDark() string {
+	if x != nil {
+		return x.Dark
+	}
+	return ""
+}
+
+func (x *Config_Theme) GetLight() string {
+	if x != nil {
+		return x.Light
+	}
+	return ""
+}
+
 type Config_Audio struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -502,7 +826,7 @@ type Config_Audio struct {
 func (x *Config_Audio) Reset() {
 	*x = Config_Audio{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[4]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -515,7 +839,7 @@ func (x *Config_Audio) String() string {
 func (*Config_Audio) ProtoMessage() {}
 
 func (x *Config_Audio) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[4]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +852,7 @@ func (x *Config_Audio) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config_Audio.ProtoReflect.Descriptor instead.
 func (*Config_Audio) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2, 1}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 2}
 }
 
 func (x *Config_Audio) GetEnabled() bool {
@@ -570,7 +894,7 @@ type Config_DBUS_Notifications struct {
 func (x *Config_DBUS_Notifications) Reset() {
 	*x = Config_DBUS_Notifications{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[5]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -583,7 +907,7 @@ func (x *Config_DBUS_Notifications) String() string {
 func (*Config_DBUS_Notifications) ProtoMessage() {}
 
 func (x *Config_DBUS_Notifications) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[5]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +920,7 @@ func (x *Config_DBUS_Notifications) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config_DBUS_Notifications.ProtoReflect.Descriptor instead.
 func (*Config_DBUS_Notifications) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2, 0, 0}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 0, 0}
 }
 
 func (x *Config_DBUS_Notifications) GetEnabled() bool {
@@ -617,7 +941,7 @@ type Config_DBUS_Systray struct {
 func (x *Config_DBUS_Systray) Reset() {
 	*x = Config_DBUS_Systray{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[6]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -630,7 +954,7 @@ func (x *Config_DBUS_Systray) String() string {
 func (*Config_DBUS_Systray) ProtoMessage() {}
 
 func (x *Config_DBUS_Systray) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[6]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,7 +967,7 @@ func (x *Config_DBUS_Systray) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config_DBUS_Systray.ProtoReflect.Descriptor instead.
 func (*Config_DBUS_Systray) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2, 0, 1}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 0, 1}
 }
 
 func (x *Config_DBUS_Systray) GetEnabled() bool {
@@ -664,7 +988,7 @@ type Config_DBUS_Shortcuts struct {
 func (x *Config_DBUS_Shortcuts) Reset() {
 	*x = Config_DBUS_Shortcuts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[7]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -677,7 +1001,7 @@ func (x *Config_DBUS_Shortcuts) String() string {
 func (*Config_DBUS_Shortcuts) ProtoMessage() {}
 
 func (x *Config_DBUS_Shortcuts) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[7]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +1014,7 @@ func (x *Config_DBUS_Shortcuts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config_DBUS_Shortcuts.ProtoReflect.Descriptor instead.
 func (*Config_DBUS_Shortcuts) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2, 0, 2}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 0, 2}
 }
 
 func (x *Config_DBUS_Shortcuts) GetEnabled() bool {
@@ -715,7 +1039,7 @@ type Config_DBUS_Brightness struct {
 func (x *Config_DBUS_Brightness) Reset() {
 	*x = Config_DBUS_Brightness{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[8]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -728,7 +1052,7 @@ func (x *Config_DBUS_Brightness) String() string {
 func (*Config_DBUS_Brightness) ProtoMessage() {}
 
 func (x *Config_DBUS_Brightness) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[8]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +1065,7 @@ func (x *Config_DBUS_Brightness) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config_DBUS_Brightness.ProtoReflect.Descriptor instead.
 func (*Config_DBUS_Brightness) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2, 0, 3}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 0, 3}
 }
 
 func (x *Config_DBUS_Brightness) GetEnabled() bool {
@@ -795,7 +1119,7 @@ type Config_DBUS_Power struct {
 func (x *Config_DBUS_Power) Reset() {
 	*x = Config_DBUS_Power{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[9]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -808,7 +1132,7 @@ func (x *Config_DBUS_Power) String() string {
 func (*Config_DBUS_Power) ProtoMessage() {}
 
 func (x *Config_DBUS_Power) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[9]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +1145,7 @@ func (x *Config_DBUS_Power) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config_DBUS_Power.ProtoReflect.Descriptor instead.
 func (*Config_DBUS_Power) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2, 0, 4}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 0, 4}
 }
 
 func (x *Config_DBUS_Power) GetEnabled() bool {
@@ -877,7 +1201,7 @@ type Config_DBUS_IdleInhibitor struct {
 func (x *Config_DBUS_IdleInhibitor) Reset() {
 	*x = Config_DBUS_IdleInhibitor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[10]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -890,7 +1214,7 @@ func (x *Config_DBUS_IdleInhibitor) String() string {
 func (*Config_DBUS_IdleInhibitor) ProtoMessage() {}
 
 func (x *Config_DBUS_IdleInhibitor) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[10]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,7 +1227,7 @@ func (x *Config_DBUS_IdleInhibitor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config_DBUS_IdleInhibitor.ProtoReflect.Descriptor instead.
 func (*Config_DBUS_IdleInhibitor) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2, 0, 5}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 0, 5}
 }
 
 func (x *Config_DBUS_IdleInhibitor) GetEnabled() bool {
@@ -924,7 +1248,7 @@ type Config_DBUS_MediaPlayer struct {
 func (x *Config_DBUS_MediaPlayer) Reset() {
 	*x = Config_DBUS_MediaPlayer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[11]
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -937,7 +1261,7 @@ func (x *Config_DBUS_MediaPlayer) String() string {
 func (*Config_DBUS_MediaPlayer) ProtoMessage() {}
 
 func (x *Config_DBUS_MediaPlayer) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[11]
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1274,7 @@ func (x *Config_DBUS_MediaPlayer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config_DBUS_MediaPlayer.ProtoReflect.Descriptor instead.
 func (*Config_DBUS_MediaPlayer) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{2, 0, 6}
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 0, 6}
 }
 
 func (x *Config_DBUS_MediaPlayer) GetEnabled() bool {
@@ -960,6 +1284,53 @@ func (x *Config_DBUS_MediaPlayer) GetEnabled() bool {
 	return false
 }
 
+type Config_DBUS_Appearance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"` // follow the desktop colour scheme from the Settings portal, switching between the dark and light theme palettes.
+}
+
+func (x *Config_DBUS_Appearance) Reset() {
+	*x = Config_DBUS_Appearance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_config_v1_config_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config_DBUS_Appearance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config_DBUS_Appearance) ProtoMessage() {}
+
+func (x *Config_DBUS_Appearance) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_config_v1_config_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config_DBUS_Appearance.ProtoReflect.Descriptor instead.
+func (*Config_DBUS_Appearance) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_config_v1_config_proto_rawDescGZIP(), []int{5, 0, 7}
+}
+
+func (x *Config_DBUS_Appearance) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
 var File_hyprpanel_config_v1_config_proto protoreflect.FileDescriptor
 
 var file_hyprpanel_config_v1_config_proto_rawDesc = []byte{
@@ -970,7 +1341,7 @@ var file_hyprpanel_config_v1_config_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
 	0x65, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x05, 0x50, 0x61,
+	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x01, 0x0a, 0x05, 0x50, 0x61,
 	0x6e, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x65, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f,
@@ -981,163 +1352,226 @@ var file_hyprpanel_config_v1_config_proto_rawDesc = []byte{
 	0x12, 0x35, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x6d, 0x6f,
 	0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x0c, 0x49, 0x63, 0x6f, 0x6e, 0x4f,
-	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0x9a,
-	0x0f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x6f, 0x67,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68,
-	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x41, 0x0a, 0x1b, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x75, 0x62,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6a, 0x6f, 0x75,
-	0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x18,
-	0x6c, 0x6f, 0x67, 0x53, 0x75, 0x62, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x54,
-	0x6f, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x62, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53, 0x52, 0x04, 0x64, 0x62, 0x75, 0x73, 0x12, 0x37,
-	0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x6e, 0x65, 0x6c, 0x52, 0x06, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x69,
-	0x63, 0x6f, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x4f, 0x76,
-	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0d, 0x69, 0x63, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72,
-	0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x5f,
-	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c,
-	0x61, 0x75, 0x6e, 0x63, 0x68, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x1a, 0xc7, 0x0a, 0x0a,
-	0x04, 0x44, 0x42, 0x55, 0x53, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
-	0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x54, 0x0a, 0x0d, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42,
-	0x55, 0x53, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x42, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42,
-	0x55, 0x53, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x52, 0x07, 0x73, 0x79, 0x73, 0x74,
-	0x72, 0x61, 0x79, 0x12, 0x48, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75,
-	0x74, 0x73, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x12, 0x4b, 0x0a,
-	0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44,
-	0x42, 0x55, 0x53, 0x2e, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x0a,
-	0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53, 0x2e, 0x50, 0x6f, 0x77, 0x65,
-	0x72, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x0e, 0x69, 0x64, 0x6c, 0x65,
-	0x5f, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42,
-	0x55, 0x53, 0x2e, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72,
-	0x52, 0x0d, 0x69, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x12,
-	0x4f, 0x0a, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x22, 0x74, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x6b, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22, 0xa6, 0x01,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a,
+	0x08, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x08, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x61,
+	0x63, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x52, 0x06,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x45, 0x0a, 0x0c, 0x49, 0x63, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x22, 0xc6, 0x13, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x41, 0x0a,
+	0x1b, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x75, 0x62, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x18, 0x6c, 0x6f, 0x67, 0x53, 0x75, 0x62, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x54, 0x6f, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x12, 0x34, 0x0a, 0x04, 0x64, 0x62, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53,
+	0x52, 0x04, 0x64, 0x62, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
 	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x1a, 0x29, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0x23, 0x0a, 0x07, 0x53,
-	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x1a, 0x25, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0xcf, 0x01, 0x0a, 0x0a, 0x42, 0x72, 0x69, 0x67,
-	0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x2e, 0x0a, 0x13, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61,
-	0x64, 0x6a, 0x75, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65,
-	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x42, 0x72, 0x69,
-	0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x11,
-	0x68, 0x75, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x75, 0x64, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xe6, 0x01, 0x0a, 0x05, 0x50, 0x6f,
-	0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x29,
-	0x0a, 0x10, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63,
-	0x61, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72,
-	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x75, 0x64, 0x5f, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x10, 0x68, 0x75, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x1a, 0x29, 0x0a, 0x0d, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69,
-	0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0x27, 0x0a,
-	0x0b, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x67, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x12,
+	0x32, 0x0a, 0x06, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x52, 0x06, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0d,
+	0x69, 0x63, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x05,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x05,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x1a, 0xbc, 0x0b, 0x0a, 0x04, 0x44, 0x42, 0x55, 0x53, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x44, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x54, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x74,
+	0x72, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x72, 0x61, 0x79, 0x52, 0x07, 0x73, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x12, 0x48, 0x0a, 0x09,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55,
+	0x53, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x52, 0x09, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53, 0x2e, 0x42, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x44, 0x42, 0x55, 0x53, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x55, 0x0a, 0x0e, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69,
+	0x74, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53, 0x2e, 0x49, 0x64, 0x6c, 0x65,
+	0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x69, 0x64, 0x6c, 0x65, 0x49,
+	0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x0c, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x0b, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0a, 0x61, 0x70, 0x70,
+	0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x42, 0x55, 0x53, 0x2e,
+	0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65,
+	0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x29, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x1a, 0x23, 0x0a, 0x07, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0x25, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x63,
+	0x75, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0xcf, 0x01,
+	0x0a, 0x0a, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
 	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0xb2, 0x01, 0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x12, 0x2b,
-	0x0a, 0x11, 0x68, 0x75, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x75, 0x64, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x5a, 0x0a, 0x04, 0x45,
-	0x64, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x44, 0x47,
-	0x45, 0x5f, 0x54, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x44, 0x47, 0x45, 0x5f,
-	0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x44, 0x47, 0x45, 0x5f,
-	0x42, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x44, 0x47, 0x45,
-	0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x04, 0x2a, 0x9f, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45,
-	0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x54, 0x52, 0x41,
-	0x43, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45,
-	0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47,
-	0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12, 0x12, 0x0a,
-	0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10,
-	0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x06, 0x42, 0xd1, 0x01, 0x0a, 0x17, 0x63, 0x6f,
-	0x6d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x70, 0x64, 0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x48, 0x43, 0x58, 0xaa, 0x02, 0x13, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13,
-	0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x53, 0x74, 0x65, 0x70, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x72,
+	0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x6d, 0x69, 0x6e, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x75, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68,
+	0x75, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0xe6, 0x01, 0x0a, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x68,
+	0x75, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x75, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x29, 0x0a, 0x0d, 0x49, 0x64, 0x6c, 0x65,
+	0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x1a, 0x27, 0x0a, 0x0b, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0x26, 0x0a, 0x0a,
+	0x41, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x1a, 0xf3, 0x01, 0x0a, 0x05, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x4b,
+	0x0a, 0x08, 0x70, 0x61, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x68,
+	0x65, 0x6d, 0x65, 0x2e, 0x50, 0x61, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x70, 0x61, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x6c, 0x65, 0x74, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x1a,
+	0x59, 0x0a, 0x0d, 0x50, 0x61, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb2, 0x01, 0x0a, 0x05, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x32,
+	0x0a, 0x15, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x5f,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x75, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68,
+	0x75, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a,
+	0x5a, 0x0a, 0x04, 0x45, 0x64, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x44, 0x47, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45,
+	0x44, 0x47, 0x45, 0x5f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x45,
+	0x44, 0x47, 0x45, 0x5f, 0x42, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09,
+	0x45, 0x44, 0x47, 0x45, 0x5f, 0x4c, 0x45, 0x46, 0x54, 0x10, 0x04, 0x2a, 0x9f, 0x01, 0x0a, 0x08,
+	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x4f, 0x47, 0x5f,
+	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c,
+	0x5f, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f,
+	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10,
+	0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57,
+	0x41, 0x52, 0x4e, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x47, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f,
+	0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x06, 0x42, 0xd1, 0x01,
+	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x64, 0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x43, 0x58, 0xaa, 0x02, 0x13, 0x48, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x13, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x5c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x48, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1153,47 +1587,62 @@ func file_hyprpanel_config_v1_config_proto_rawDescGZIP() []byte {
 }
 
 var file_hyprpanel_config_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_hyprpanel_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_hyprpanel_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_hyprpanel_config_v1_config_proto_goTypes = []interface{}{
 	(Edge)(0),                         // 0: hyprpanel.config.v1.Edge
 	(LogLevel)(0),                     // 1: hyprpanel.config.v1.LogLevel
 	(*Panel)(nil),                     // 2: hyprpanel.config.v1.Panel
-	(*IconOverride)(nil),              // 3: hyprpanel.config.v1.IconOverride
-	(*Config)(nil),                    // 4: hyprpanel.config.v1.Config
-	(*Config_DBUS)(nil),               // 5: hyprpanel.config.v1.Config.DBUS
-	(*Config_Audio)(nil),              // 6: hyprpanel.config.v1.Config.Audio
-	(*Config_DBUS_Notifications)(nil), // 7: hyprpanel.config.v1.Config.DBUS.Notifications
-	(*Config_DBUS_Systray)(nil),       // 8: hyprpanel.config.v1.Config.DBUS.Systray
-	(*Config_DBUS_Shortcuts)(nil),     // 9: hyprpanel.config.v1.Config.DBUS.Shortcuts
-	(*Config_DBUS_Brightness)(nil),    // 10: hyprpanel.config.v1.Config.DBUS.Brightness
-	(*Config_DBUS_Power)(nil),         // 11: hyprpanel.config.v1.Config.DBUS.Power
-	(*Config_DBUS_IdleInhibitor)(nil), // 12: hyprpanel.config.v1.Config.DBUS.IdleInhibitor
-	(*Config_DBUS_MediaPlayer)(nil),   // 13: hyprpanel.config.v1.Config.DBUS.MediaPlayer
-	(*v1.Module)(nil),                 // 14: hyprpanel.module.v1.Module
-	(*durationpb.Duration)(nil),       // 15: google.protobuf.Duration
+	(*MonitorMatch)(nil),              // 3: hyprpanel.config.v1.MonitorMatch
+	(*Profile)(nil),                   // 4: hyprpanel.config.v1.Profile
+	(*Palette)(nil),                   // 5: hyprpanel.config.v1.Palette
+	(*IconOverride)(nil),              // 6: hyprpanel.config.v1.IconOverride
+	(*Config)(nil),                    // 7: hyprpanel.config.v1.Config
+	nil,                               // 8: hyprpanel.config.v1.Palette.ColorsEntry
+	(*Config_DBUS)(nil),               // 9: hyprpanel.config.v1.Config.DBUS
+	(*Config_Theme)(nil),              // 10: hyprpanel.config.v1.Config.Theme
+	(*Config_Audio)(nil),              // 11: hyprpanel.config.v1.Config.Audio
+	(*Config_DBUS_Notifications)(nil), // 12: hyprpanel.config.v1.Config.DBUS.Notifications
+	(*Config_DBUS_Systray)(nil),       // 13: hyprpanel.config.v1.Config.DBUS.Systray
+	(*Config_DBUS_Shortcuts)(nil),     // 14: hyprpanel.config.v1.Config.DBUS.Shortcuts
+	(*Config_DBUS_Brightness)(nil),    // 15: hyprpanel.config.v1.Config.DBUS.Brightness
+	(*Config_DBUS_Power)(nil),         // 16: hyprpanel.config.v1.Config.DBUS.Power
+	(*Config_DBUS_IdleInhibitor)(nil), // 17: hyprpanel.config.v1.Config.DBUS.IdleInhibitor
+	(*Config_DBUS_MediaPlayer)(nil),   // 18: hyprpanel.config.v1.Config.DBUS.MediaPlayer
+	(*Config_DBUS_Appearance)(nil),    // 19: hyprpanel.config.v1.Config.DBUS.Appearance
+	nil,                               // 20: hyprpanel.config.v1.Config.Theme.PalettesEntry
+	(*v1.Module)(nil),                 // 21: hyprpanel.module.v1.Module
+	(*durationpb.Duration)(nil),       // 22: google.protobuf.Duration
 }
 var file_hyprpanel_config_v1_config_proto_depIdxs = []int32{
 	0,  // 0: hyprpanel.config.v1.Panel.edge:type_name -> hyprpanel.config.v1.Edge
-	14, // 1: hyprpanel.config.v1.Panel.modules:type_name -> hyprpanel.module.v1.Module
-	1,  // 2: hyprpanel.config.v1.Config.log_level:type_name -> hyprpanel.config.v1.LogLevel
-	5,  // 3: hyprpanel.config.v1.Config.dbus:type_name -> hyprpanel.config.v1.Config.DBUS
-	6,  // 4: hyprpanel.config.v1.Config.audio:type_name -> hyprpanel.config.v1.Config.Audio
-	2,  // 5: hyprpanel.config.v1.Config.panels:type_name -> hyprpanel.config.v1.Panel
-	3,  // 6: hyprpanel.config.v1.Config.icon_overrides:type_name -> hyprpanel.config.v1.IconOverride
-	15, // 7: hyprpanel.config.v1.Config.DBUS.connect_timeout:type_name -> google.protobuf.Duration
-	15, // 8: hyprpanel.config.v1.Config.DBUS.connect_interval:type_name -> google.protobuf.Duration
-	7,  // 9: hyprpanel.config.v1.Config.DBUS.notifications:type_name -> hyprpanel.config.v1.Config.DBUS.Notifications
-	8,  // 10: hyprpanel.config.v1.Config.DBUS.systray:type_name -> hyprpanel.config.v1.Config.DBUS.Systray
-	9,  // 11: hyprpanel.config.v1.Config.DBUS.shortcuts:type_name -> hyprpanel.config.v1.Config.DBUS.Shortcuts
-	10, // 12: hyprpanel.config.v1.Config.DBUS.brightness:type_name -> hyprpanel.config.v1.Config.DBUS.Brightness
-	11, // 13: hyprpanel.config.v1.Config.DBUS.power:type_name -> hyprpanel.config.v1.Config.DBUS.Power
-	12, // 14: hyprpanel.config.v1.Config.DBUS.idle_inhibitor:type_name -> hyprpanel.config.v1.Config.DBUS.IdleInhibitor
-	13, // 15: hyprpanel.config.v1.Config.DBUS.media_player:type_name -> hyprpanel.config.v1.Config.DBUS.MediaPlayer
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	21, // 1: hyprpanel.config.v1.Panel.modules:type_name -> hyprpanel.module.v1.Module
+	3,  // 2: hyprpanel.config.v1.Profile.monitors:type_name -> hyprpanel.config.v1.MonitorMatch
+	2,  // 3: hyprpanel.config.v1.Profile.panels:type_name -> hyprpanel.config.v1.Panel
+	8,  // 4: hyprpanel.config.v1.Palette.colors:type_name -> hyprpanel.config.v1.Palette.ColorsEntry
+	1,  // 5: hyprpanel.config.v1.Config.log_level:type_name -> hyprpanel.config.v1.LogLevel
+	9,  // 6: hyprpanel.config.v1.Config.dbus:type_name -> hyprpanel.config.v1.Config.DBUS
+	11, // 7: hyprpanel.config.v1.Config.audio:type_name -> hyprpanel.config.v1.Config.Audio
+	2,  // 8: hyprpanel.config.v1.Config.panels:type_name -> hyprpanel.config.v1.Panel
+	6,  // 9: hyprpanel.config.v1.Config.icon_overrides:type_name -> hyprpanel.config.v1.IconOverride
+	4,  // 10: hyprpanel.config.v1.Config.profiles:type_name -> hyprpanel.config.v1.Profile
+	10, // 11: hyprpanel.config.v1.Config.theme:type_name -> hyprpanel.config.v1.Config.Theme
+	22, // 12: hyprpanel.config.v1.Config.DBUS.connect_timeout:type_name -> google.protobuf.Duration
+	22, // 13: hyprpanel.config.v1.Config.DBUS.connect_interval:type_name -> google.protobuf.Duration
+	12, // 14: hyprpanel.config.v1.Config.DBUS.notifications:type_name -> hyprpanel.config.v1.Config.DBUS.Notifications
+	13, // 15: hyprpanel.config.v1.Config.DBUS.systray:type_name -> hyprpanel.config.v1.Config.DBUS.Systray
+	14, // 16: hyprpanel.config.v1.Config.DBUS.shortcuts:type_name -> hyprpanel.config.v1.Config.DBUS.Shortcuts
+	15, // 17: hyprpanel.config.v1.Config.DBUS.brightness:type_name -> hyprpanel.config.v1.Config.DBUS.Brightness
+	16, // 18: hyprpanel.config.v1.Config.DBUS.power:type_name -> hyprpanel.config.v1.Config.DBUS.Power
+	17, // 19: hyprpanel.config.v1.Config.DBUS.idle_inhibitor:type_name -> hyprpanel.config.v1.Config.DBUS.IdleInhibitor
+	18, // 20: hyprpanel.config.v1.Config.DBUS.media_player:type_name -> hyprpanel.config.v1.Config.DBUS.MediaPlayer
+	19, // 21: hyprpanel.config.v1.Config.DBUS.appearance:type_name -> hyprpanel.config.v1.Config.DBUS.Appearance
+	20, // 22: hyprpanel.config.v1.Config.Theme.palettes:type_name -> hyprpanel.config.v1.Config.Theme.PalettesEntry
+	5,  // 23: hyprpanel.config.v1.Config.Theme.PalettesEntry.value:type_name -> hyprpanel.config.v1.Palette
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_hyprpanel_config_v1_config_proto_init() }
@@ -1215,7 +1664,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 			}
 		}
 		file_hyprpanel_config_v1_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IconOverride); i {
+			switch v := v.(*MonitorMatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1227,7 +1676,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 			}
 		}
 		file_hyprpanel_config_v1_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+			switch v := v.(*Profile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1239,7 +1688,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 			}
 		}
 		file_hyprpanel_config_v1_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config_DBUS); i {
+			switch v := v.(*Palette); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1251,7 +1700,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 			}
 		}
 		file_hyprpanel_config_v1_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config_Audio); i {
+			switch v := v.(*IconOverride); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1263,6 +1712,54 @@ func file_hyprpanel_config_v1_config_proto_init() {
 			}
 		}
 		file_hyprpanel_config_v1_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_config_v1_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config_DBUS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_config_v1_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config_Theme); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_config_v1_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config_Audio); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_config_v1_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_DBUS_Notifications); i {
 			case 0:
 				return &v.state
@@ -1274,7 +1771,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_config_v1_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_config_v1_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_DBUS_Systray); i {
 			case 0:
 				return &v.state
@@ -1286,7 +1783,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_config_v1_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_config_v1_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_DBUS_Shortcuts); i {
 			case 0:
 				return &v.state
@@ -1298,7 +1795,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_config_v1_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_config_v1_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_DBUS_Brightness); i {
 			case 0:
 				return &v.state
@@ -1310,7 +1807,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_config_v1_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_config_v1_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_DBUS_Power); i {
 			case 0:
 				return &v.state
@@ -1322,7 +1819,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_config_v1_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_config_v1_config_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_DBUS_IdleInhibitor); i {
 			case 0:
 				return &v.state
@@ -1334,7 +1831,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_config_v1_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_config_v1_config_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_DBUS_MediaPlayer); i {
 			case 0:
 				return &v.state
@@ -1346,6 +1843,18 @@ func file_hyprpanel_config_v1_config_proto_init() {
 				return nil
 			}
 		}
+		file_hyprpanel_config_v1_config_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config_DBUS_Appearance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1353,7 +1862,7 @@ func file_hyprpanel_config_v1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hyprpanel_config_v1_config_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   12,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
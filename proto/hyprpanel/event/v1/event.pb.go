@@ -305,6 +305,55 @@ func (MediaPlayerState) EnumDescriptor() ([]byte, []int) {
 	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{4}
 }
 
+type ColorScheme int32
+
+const (
+	ColorScheme_COLOR_SCHEME_UNSPECIFIED ColorScheme = 0 // no preference.
+	ColorScheme_COLOR_SCHEME_DARK        ColorScheme = 1
+	ColorScheme_COLOR_SCHEME_LIGHT       ColorScheme = 2
+)
+
+// Enum value maps for ColorScheme.
+var (
+	ColorScheme_name = map[int32]string{
+		0: "COLOR_SCHEME_UNSPECIFIED",
+		1: "COLOR_SCHEME_DARK",
+		2: "COLOR_SCHEME_LIGHT",
+	}
+	ColorScheme_value = map[string]int32{
+		"COLOR_SCHEME_UNSPECIFIED": 0,
+		"COLOR_SCHEME_DARK":        1,
+		"COLOR_SCHEME_LIGHT":       2,
+	}
+)
+
+func (x ColorScheme) Enum() *ColorScheme {
+	p := new(ColorScheme)
+	*p = x
+	return p
+}
+
+func (x ColorScheme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ColorScheme) Descriptor() protoreflect.EnumDescriptor {
+	return file_hyprpanel_event_v1_event_proto_enumTypes[5].Descriptor()
+}
+
+func (ColorScheme) Type() protoreflect.EnumType {
+	return &file_hyprpanel_event_v1_event_proto_enumTypes[5]
+}
+
+func (x ColorScheme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ColorScheme.Descriptor instead.
+func (ColorScheme) EnumDescriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{5}
+}
+
 type EventKind int32
 
 const (
@@ -370,6 +419,7 @@ const (
 	EventKind_EVENT_KIND_IDLE_INHIBITOR_INHIBIT        EventKind = 60
 	EventKind_EVENT_KIND_IDLE_INHIBITOR_UNINHIBIT      EventKind = 61
 	EventKind_EVENT_KIND_MEDIA_PLAYER_CHANGE           EventKind = 62
+	EventKind_EVENT_KIND_DBUS_COLOR_SCHEME_CHANGE      EventKind = 63
 )
 
 // Enum value maps for EventKind.
@@ -437,6 +487,7 @@ var (
 		60: "EVENT_KIND_IDLE_INHIBITOR_INHIBIT",
 		61: "EVENT_KIND_IDLE_INHIBITOR_UNINHIBIT",
 		62: "EVENT_KIND_MEDIA_PLAYER_CHANGE",
+		63: "EVENT_KIND_DBUS_COLOR_SCHEME_CHANGE",
 	}
 	EventKind_value = map[string]int32{
 		"EVENT_KIND_UNSPECIFIED":                   0,
@@ -501,6 +552,7 @@ var (
 		"EVENT_KIND_IDLE_INHIBITOR_INHIBIT":        60,
 		"EVENT_KIND_IDLE_INHIBITOR_UNINHIBIT":      61,
 		"EVENT_KIND_MEDIA_PLAYER_CHANGE":           62,
+		"EVENT_KIND_DBUS_COLOR_SCHEME_CHANGE":      63,
 	}
 )
 
@@ -515,11 +567,11 @@ func (x EventKind) String() string {
 }
 
 func (EventKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_hyprpanel_event_v1_event_proto_enumTypes[5].Descriptor()
+	return file_hyprpanel_event_v1_event_proto_enumTypes[6].Descriptor()
 }
 
 func (EventKind) Type() protoreflect.EnumType {
-	return &file_hyprpanel_event_v1_event_proto_enumTypes[5]
+	return &file_hyprpanel_event_v1_event_proto_enumTypes[6]
 }
 
 func (x EventKind) Number() protoreflect.EnumNumber {
@@ -528,7 +580,7 @@ func (x EventKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventKind.Descriptor instead.
 func (EventKind) EnumDescriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{5}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{6}
 }
 
 type MediaPlayerValueChange struct {
@@ -536,7 +588,7 @@ type MediaPlayerValueChange struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State         MediaPlayerState       `protobuf:"varint,1,opt,name=state,proto3,enum=hyprpanel.event.v1.MediaPlayerState" json:"state,omitempty"`
+	State         MediaPlayerState       `protobuf:"varint,1,opt,name=state,proto3,enum=hyprpanel.event.v1.MediaPlayerState" json:"state,omitempty"` // STOPPED, without identity or track, once the last player has exited.
 	CanGoNext     bool                   `protobuf:"varint,2,opt,name=can_go_next,json=canGoNext,proto3" json:"can_go_next,omitempty"`
 	CanGoPrevious bool                   `protobuf:"varint,3,opt,name=can_go_previous,json=canGoPrevious,proto3" json:"can_go_previous,omitempty"`
 	TrackId       string                 `protobuf:"bytes,4,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
@@ -2574,6 +2626,53 @@ func (x *IdleInhibitorValue) GetTarget() InhibitTarget {
 	return InhibitTarget_INHIBIT_TARGET_UNSPECIFIED
 }
 
+type ColorSchemeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ColorScheme ColorScheme `protobuf:"varint,1,opt,name=color_scheme,json=colorScheme,proto3,enum=hyprpanel.event.v1.ColorScheme" json:"color_scheme,omitempty"`
+}
+
+func (x *ColorSchemeValue) Reset() {
+	*x = ColorSchemeValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorSchemeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorSchemeValue) ProtoMessage() {}
+
+func (x *ColorSchemeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorSchemeValue.ProtoReflect.Descriptor instead.
+func (*ColorSchemeValue) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ColorSchemeValue) GetColorScheme() ColorScheme {
+	if x != nil {
+		return x.ColorScheme
+	}
+	return ColorScheme_COLOR_SCHEME_UNSPECIFIED
+}
+
 type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2586,7 +2685,7 @@ type Event struct {
 func (x *Event) Reset() {
 	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[29]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2599,7 +2698,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[29]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2612,7 +2711,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{29}
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Event) GetKind() EventKind {
@@ -2629,6 +2728,152 @@ func (x *Event) GetData() *anypb.Any {
 	return nil
 }
 
+// HyprSnapshot holds the JSON responses to Hyprland queries, with fields
+// numbered by hyprpanel.v1.HyprQuery.
+type HyprSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clients         []byte `protobuf:"bytes,1,opt,name=clients,proto3" json:"clients,omitempty"`
+	Monitors        []byte `protobuf:"bytes,2,opt,name=monitors,proto3" json:"monitors,omitempty"`
+	Workspaces      []byte `protobuf:"bytes,3,opt,name=workspaces,proto3" json:"workspaces,omitempty"`
+	ActiveWindow    []byte `protobuf:"bytes,4,opt,name=active_window,json=activeWindow,proto3" json:"active_window,omitempty"`
+	ActiveWorkspace []byte `protobuf:"bytes,5,opt,name=active_workspace,json=activeWorkspace,proto3" json:"active_workspace,omitempty"`
+}
+
+func (x *HyprSnapshot) Reset() {
+	*x = HyprSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HyprSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HyprSnapshot) ProtoMessage() {}
+
+func (x *HyprSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HyprSnapshot.ProtoReflect.Descriptor instead.
+func (*HyprSnapshot) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *HyprSnapshot) GetClients() []byte {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+func (x *HyprSnapshot) GetMonitors() []byte {
+	if x != nil {
+		return x.Monitors
+	}
+	return nil
+}
+
+func (x *HyprSnapshot) GetWorkspaces() []byte {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+func (x *HyprSnapshot) GetActiveWindow() []byte {
+	if x != nil {
+		return x.ActiveWindow
+	}
+	return nil
+}
+
+func (x *HyprSnapshot) GetActiveWorkspace() []byte {
+	if x != nil {
+		return x.ActiveWorkspace
+	}
+	return nil
+}
+
+type RecordedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event     *Event                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// Hyprland state at the time of recording, served to panel queries during
+	// replay until the next snapshot. Precedes the event it was taken for.
+	HyprSnapshot *HyprSnapshot `protobuf:"bytes,3,opt,name=hypr_snapshot,json=hyprSnapshot,proto3" json:"hypr_snapshot,omitempty"`
+}
+
+func (x *RecordedEvent) Reset() {
+	*x = RecordedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordedEvent) ProtoMessage() {}
+
+func (x *RecordedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordedEvent.ProtoReflect.Descriptor instead.
+func (*RecordedEvent) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_event_v1_event_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RecordedEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *RecordedEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RecordedEvent) GetHyprSnapshot() *HyprSnapshot {
+	if x != nil {
+		return x.HyprSnapshot
+	}
+	return nil
+}
+
 type StatusNotifierValue_Pixmap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2642,7 +2887,7 @@ type StatusNotifierValue_Pixmap struct {
 func (x *StatusNotifierValue_Pixmap) Reset() {
 	*x = StatusNotifierValue_Pixmap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[30]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2655,7 +2900,7 @@ func (x *StatusNotifierValue_Pixmap) String() string {
 func (*StatusNotifierValue_Pixmap) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Pixmap) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[30]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2706,7 +2951,7 @@ type StatusNotifierValue_Tooltip struct {
 func (x *StatusNotifierValue_Tooltip) Reset() {
 	*x = StatusNotifierValue_Tooltip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[31]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2719,7 +2964,7 @@ func (x *StatusNotifierValue_Tooltip) String() string {
 func (*StatusNotifierValue_Tooltip) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Tooltip) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[31]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,7 +3021,7 @@ type StatusNotifierValue_Icon struct {
 func (x *StatusNotifierValue_Icon) Reset() {
 	*x = StatusNotifierValue_Icon{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[32]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2789,7 +3034,7 @@ func (x *StatusNotifierValue_Icon) String() string {
 func (*StatusNotifierValue_Icon) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Icon) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[32]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2839,7 +3084,7 @@ type StatusNotifierValue_Menu struct {
 func (x *StatusNotifierValue_Menu) Reset() {
 	*x = StatusNotifierValue_Menu{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[33]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2852,7 +3097,7 @@ func (x *StatusNotifierValue_Menu) String() string {
 func (*StatusNotifierValue_Menu) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Menu) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[33]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2909,7 +3154,7 @@ type StatusNotifierValue_Menu_Properties struct {
 func (x *StatusNotifierValue_Menu_Properties) Reset() {
 	*x = StatusNotifierValue_Menu_Properties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[34]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2922,7 +3167,7 @@ func (x *StatusNotifierValue_Menu_Properties) String() string {
 func (*StatusNotifierValue_Menu_Properties) ProtoMessage() {}
 
 func (x *StatusNotifierValue_Menu_Properties) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[34]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3020,7 +3265,7 @@ type NotificationValue_Hint struct {
 func (x *NotificationValue_Hint) Reset() {
 	*x = NotificationValue_Hint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[35]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3033,7 +3278,7 @@ func (x *NotificationValue_Hint) String() string {
 func (*NotificationValue_Hint) ProtoMessage() {}
 
 func (x *NotificationValue_Hint) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[35]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3075,7 +3320,7 @@ type NotificationValue_Action struct {
 func (x *NotificationValue_Action) Reset() {
 	*x = NotificationValue_Action{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[36]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3088,7 +3333,7 @@ func (x *NotificationValue_Action) String() string {
 func (*NotificationValue_Action) ProtoMessage() {}
 
 func (x *NotificationValue_Action) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[36]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3135,7 +3380,7 @@ type NotificationValue_Pixmap struct {
 func (x *NotificationValue_Pixmap) Reset() {
 	*x = NotificationValue_Pixmap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[37]
+		mi := &file_hyprpanel_event_v1_event_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3148,7 +3393,7 @@ func (x *NotificationValue_Pixmap) String() string {
 func (*NotificationValue_Pixmap) ProtoMessage() {}
 
 func (x *NotificationValue_Pixmap) ProtoReflect() protoreflect.Message {
-	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[37]
+	mi := &file_hyprpanel_event_v1_event_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3585,212 +3830,250 @@ var file_hyprpanel_event_v1_event_proto_rawDesc = []byte{
 	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
 	0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x68, 0x69, 0x62,
 	0x69, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x22, 0x64, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x4c, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10,
-	0x0a, 0x0c, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x10, 0x01,
-	0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f,
-	0x57, 0x4e, 0x10, 0x02, 0x2a, 0xdf, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19,
-	0x0a, 0x15, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4e,
-	0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4f, 0x57,
-	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x54, 0x45, 0x52, 0x59, 0x10,
-	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x50, 0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x14, 0x0a,
-	0x10, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x55, 0x53,
-	0x45, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x4b, 0x45, 0x59, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e,
-	0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x44, 0x41, 0x10, 0x07,
-	0x12, 0x14, 0x0a, 0x10, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
-	0x48, 0x4f, 0x4e, 0x45, 0x10, 0x08, 0x2a, 0xd9, 0x01, 0x0a, 0x0a, 0x50, 0x6f, 0x77, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
-	0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43,
-	0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x4f, 0x57,
-	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x03,
-	0x12, 0x1d, 0x0a, 0x19, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x44, 0x10, 0x04, 0x12,
-	0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50,
-	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x10, 0x05, 0x12,
-	0x21, 0x0a, 0x1d, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50,
-	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45,
-	0x10, 0x06, 0x2a, 0x7f, 0x0a, 0x0d, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49, 0x54, 0x5f, 0x54,
-	0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49, 0x54, 0x5f, 0x54,
-	0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
-	0x49, 0x4e, 0x48, 0x49, 0x42, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x53,
-	0x4c, 0x45, 0x45, 0x50, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49,
-	0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57,
-	0x4e, 0x10, 0x03, 0x2a, 0x95, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45, 0x44, 0x49,
-	0x41, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a,
-	0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
-	0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4d,
+	0x22, 0x56, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x31, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb4,
+	0x01, 0x0a, 0x0c, 0x48, 0x79, 0x70, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0d, 0x68, 0x79, 0x70, 0x72, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x79, 0x70, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x0c, 0x68, 0x79, 0x70,
+	0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2a, 0x4c, 0x0a, 0x09, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x50, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x2a, 0xdf, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x54, 0x45,
+	0x52, 0x59, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x50, 0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4f, 0x57, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x04,
+	0x12, 0x14, 0x0a, 0x10, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
+	0x4f, 0x55, 0x53, 0x45, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x10, 0x06, 0x12,
+	0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x44,
+	0x41, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x08, 0x2a, 0xd9, 0x01, 0x0a, 0x0a, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x4f, 0x57, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x1b, 0x0a, 0x17, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44,
+	0x49, 0x53, 0x43, 0x48, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54,
+	0x59, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x47, 0x45,
+	0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x48, 0x41,
+	0x52, 0x47, 0x45, 0x10, 0x06, 0x2a, 0x7f, 0x0a, 0x0d, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49,
+	0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49,
+	0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x5f, 0x53, 0x4c, 0x45, 0x45, 0x50, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x48,
+	0x49, 0x42, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x48, 0x55, 0x54,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x2a, 0x95, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4d,
 	0x45, 0x44, 0x49, 0x41, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xfa, 0x10, 0x0a, 0x09,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
-	0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
-	0x43, 0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x46, 0x4f, 0x43, 0x55, 0x53, 0x45, 0x44, 0x4d,
-	0x4f, 0x4e, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x57, 0x49,
-	0x4e, 0x44, 0x4f, 0x57, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
-	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x56, 0x32, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x46, 0x55,
-	0x4c, 0x4c, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x56,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e,
+	0x0a, 0x1a, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x5a,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x18, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x43,
+	0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x44, 0x41, 0x52, 0x4b,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x4d, 0x45, 0x5f, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x2a, 0xa3, 0x11, 0x0a, 0x09, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x46, 0x4f, 0x43, 0x55, 0x53, 0x45, 0x44, 0x4d, 0x4f,
+	0x4e, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x57,
+	0x49, 0x4e, 0x44, 0x4f, 0x57, 0x56, 0x32, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x46, 0x55, 0x4c,
+	0x4c, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x4f, 0x4e,
+	0x49, 0x54, 0x4f, 0x52, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x07, 0x12, 0x20, 0x0a,
+	0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52,
+	0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x08, 0x12,
+	0x23, 0x0a, 0x1f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59,
+	0x50, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x10, 0x09, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x57,
+	0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x0a, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56,
 	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x4f,
-	0x4e, 0x49, 0x54, 0x4f, 0x52, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x07, 0x12, 0x20,
-	0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50,
-	0x52, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x08,
-	0x12, 0x23, 0x0a, 0x1f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48,
-	0x59, 0x50, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50,
-	0x41, 0x43, 0x45, 0x10, 0x09, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
-	0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59,
-	0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x0a, 0x12, 0x21, 0x0a, 0x1d, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d,
-	0x4f, 0x56, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x0b, 0x12, 0x23,
-	0x0a, 0x1f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50,
-	0x52, 0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43,
-	0x45, 0x10, 0x0c, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
-	0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x41, 0x4c, 0x10, 0x0d, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
-	0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x10, 0x0e, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e,
+	0x56, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x0b, 0x12, 0x23, 0x0a,
+	0x1f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52,
+	0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45,
+	0x10, 0x0c, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x41, 0x4c, 0x10, 0x0d, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x4c,
+	0x41, 0x59, 0x4f, 0x55, 0x54, 0x10, 0x0e, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x57,
+	0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x0f, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x10, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x4f, 0x56, 0x45,
+	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x11, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e,
 	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
-	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x0f, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
-	0x45, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x10, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x4f, 0x56,
-	0x45, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x11, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4f, 0x50, 0x45,
-	0x4e, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x12, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
-	0x45, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x13, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x53, 0x55, 0x42, 0x4d,
-	0x41, 0x50, 0x10, 0x14, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x46, 0x4c,
-	0x4f, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x15, 0x12, 0x1a, 0x0a, 0x16,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f,
-	0x55, 0x52, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x49, 0x4e, 0x49,
-	0x4d, 0x49, 0x5a, 0x45, 0x10, 0x17, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e,
-	0x43, 0x41, 0x53, 0x54, 0x10, 0x18, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57,
-	0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x19, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52,
-	0x45, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x1a, 0x12, 0x23, 0x0a, 0x1f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x4c, 0x4f, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10,
-	0x1b, 0x12, 0x2a, 0x0a, 0x26, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
-	0x44, 0x42, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x10, 0x1c, 0x12, 0x2c, 0x0a,
-	0x28, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53,
-	0x5f, 0x55, 0x4e, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x10, 0x1d, 0x12, 0x1f, 0x0a, 0x1b, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x55,
-	0x50, 0x44, 0x41, 0x54, 0x45, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x1e, 0x12, 0x21, 0x0a, 0x1d,
+	0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x12, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x13, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x41,
+	0x50, 0x10, 0x14, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x46, 0x4c, 0x4f,
+	0x41, 0x54, 0x49, 0x4e, 0x47, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x15, 0x12, 0x1a, 0x0a, 0x16, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x55,
+	0x52, 0x47, 0x45, 0x4e, 0x54, 0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d,
+	0x49, 0x5a, 0x45, 0x10, 0x17, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x53, 0x43, 0x52, 0x45, 0x45, 0x4e, 0x43,
+	0x41, 0x53, 0x54, 0x10, 0x18, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x54,
+	0x49, 0x54, 0x4c, 0x45, 0x10, 0x19, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x1a, 0x12, 0x23, 0x0a, 0x1f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x4c, 0x4f, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10, 0x1b,
+	0x12, 0x2a, 0x0a, 0x26, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44,
+	0x42, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x10, 0x1c, 0x12, 0x2c, 0x0a, 0x28,
 	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f,
-	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x54, 0x4f, 0x4f, 0x4c, 0x54, 0x49, 0x50, 0x10, 0x1f, 0x12,
-	0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42,
-	0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x49, 0x43, 0x4f, 0x4e, 0x10, 0x20, 0x12,
-	0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42,
-	0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x55, 0x10, 0x21, 0x12,
-	0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42,
-	0x55, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10,
-	0x22, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
-	0x44, 0x42, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x23, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
-	0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x4e, 0x4f, 0x54, 0x49,
-	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x24, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x42, 0x52,
-	0x49, 0x47, 0x48, 0x54, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10,
-	0x25, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
-	0x44, 0x42, 0x55, 0x53, 0x5f, 0x42, 0x52, 0x49, 0x47, 0x48, 0x54, 0x4e, 0x45, 0x53, 0x53, 0x5f,
-	0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x10, 0x26, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x49, 0x4e,
-	0x4b, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x27, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x55, 0x4e, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x45, 0x52, 0x10, 0x1d, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x1e, 0x12, 0x21, 0x0a, 0x1d, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x54, 0x4f, 0x4f, 0x4c, 0x54, 0x49, 0x50, 0x10, 0x1f, 0x12, 0x1e,
+	0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55,
+	0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x49, 0x43, 0x4f, 0x4e, 0x10, 0x20, 0x12, 0x1e,
+	0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55,
+	0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x55, 0x10, 0x21, 0x12, 0x20,
+	0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55,
+	0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x22,
+	0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44,
+	0x42, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x23, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x24, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x42, 0x52, 0x49,
+	0x47, 0x48, 0x54, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x25,
+	0x12, 0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44,
+	0x42, 0x55, 0x53, 0x5f, 0x42, 0x52, 0x49, 0x47, 0x48, 0x54, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x41,
+	0x44, 0x4a, 0x55, 0x53, 0x54, 0x10, 0x26, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54,
 	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x49, 0x4e, 0x4b,
-	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x28, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x49,
-	0x4e, 0x4b, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x29, 0x12, 0x1f, 0x0a, 0x1b, 0x45,
+	0x5f, 0x4e, 0x45, 0x57, 0x10, 0x27, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x49, 0x4e, 0x4b, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x28, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x49, 0x4e,
+	0x4b, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x29, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x2a, 0x12, 0x22, 0x0a, 0x1e, 0x45,
 	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f,
-	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x2a, 0x12, 0x22, 0x0a, 0x1e,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f,
-	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x2b,
-	0x12, 0x22, 0x0a, 0x1e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41,
-	0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f,
-	0x56, 0x45, 0x10, 0x2c, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x4e, 0x45,
-	0x57, 0x10, 0x2d, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
-	0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x43, 0x48, 0x41,
-	0x4e, 0x47, 0x45, 0x10, 0x2e, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
-	0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x52,
-	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x2f, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x49, 0x4e, 0x4b,
-	0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x10, 0x30,
-	0x12, 0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41,
-	0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x55, 0x54, 0x45, 0x5f, 0x54,
-	0x4f, 0x47, 0x47, 0x4c, 0x45, 0x10, 0x31, 0x12, 0x29, 0x0a, 0x25, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52,
-	0x43, 0x45, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54,
-	0x10, 0x32, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
-	0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4d, 0x55,
-	0x54, 0x45, 0x5f, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x10, 0x33, 0x12, 0x19, 0x0a, 0x15, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x55, 0x44, 0x5f, 0x4e, 0x4f,
-	0x54, 0x49, 0x46, 0x59, 0x10, 0x34, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f,
-	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x35, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x4f, 0x56, 0x45,
-	0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x56, 0x32, 0x10, 0x36, 0x12, 0x20, 0x0a,
-	0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52,
-	0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x56, 0x32, 0x10, 0x37, 0x12,
-	0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59,
-	0x50, 0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
-	0x43, 0x45, 0x56, 0x32, 0x10, 0x38, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f,
-	0x59, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x56, 0x32, 0x10, 0x39, 0x12, 0x1f,
-	0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50,
-	0x52, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x56, 0x32, 0x10, 0x3a, 0x12,
-	0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x58,
-	0x45, 0x43, 0x10, 0x3b, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49, 0x54, 0x4f,
-	0x52, 0x5f, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49, 0x54, 0x10, 0x3c, 0x12, 0x27, 0x0a, 0x23, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x49,
-	0x4e, 0x48, 0x49, 0x42, 0x49, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x48, 0x49, 0x42,
-	0x49, 0x54, 0x10, 0x3d, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f,
-	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x3e, 0x42, 0xc9, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d,
-	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x64,
-	0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48,
-	0x45, 0x58, 0xaa, 0x02, 0x12, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x48,
-	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14,
-	0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x2b, 0x12,
+	0x22, 0x0a, 0x1e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55,
+	0x44, 0x49, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x10, 0x2c, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x4e, 0x45, 0x57,
+	0x10, 0x2d, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x2e, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x10, 0x2f, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x49, 0x4e, 0x4b, 0x5f,
+	0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x10, 0x30, 0x12,
+	0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55,
+	0x44, 0x49, 0x4f, 0x5f, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x4f,
+	0x47, 0x47, 0x4c, 0x45, 0x10, 0x31, 0x12, 0x29, 0x0a, 0x25, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x10,
+	0x32, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x41, 0x55, 0x44, 0x49, 0x4f, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4d, 0x55, 0x54,
+	0x45, 0x5f, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x10, 0x33, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x55, 0x44, 0x5f, 0x4e, 0x4f, 0x54,
+	0x49, 0x46, 0x59, 0x10, 0x34, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x35, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x57,
+	0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x56, 0x32, 0x10, 0x36, 0x12, 0x20, 0x0a, 0x1c,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f,
+	0x4d, 0x4f, 0x56, 0x45, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x56, 0x32, 0x10, 0x37, 0x12, 0x25,
+	0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50,
+	0x52, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x56, 0x32, 0x10, 0x38, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59,
+	0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x56, 0x32, 0x10, 0x39, 0x12, 0x1f, 0x0a,
+	0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x59, 0x50, 0x52,
+	0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x56, 0x32, 0x10, 0x3a, 0x12, 0x13,
+	0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x58, 0x45,
+	0x43, 0x10, 0x3b, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49, 0x54, 0x4f, 0x52,
+	0x5f, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49, 0x54, 0x10, 0x3c, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x5f, 0x49, 0x4e,
+	0x48, 0x49, 0x42, 0x49, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x49, 0x4e, 0x48, 0x49, 0x42, 0x49,
+	0x54, 0x10, 0x3d, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x3e, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44, 0x42, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4c, 0x4f, 0x52,
+	0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x3f,
+	0x42, 0xc9, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x64, 0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x45, 0x58, 0xaa, 0x02, 0x12, 0x48, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x12, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3805,92 +4088,100 @@ func file_hyprpanel_event_v1_event_proto_rawDescGZIP() []byte {
 	return file_hyprpanel_event_v1_event_proto_rawDescData
 }
 
-var file_hyprpanel_event_v1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_hyprpanel_event_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_hyprpanel_event_v1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_hyprpanel_event_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_hyprpanel_event_v1_event_proto_goTypes = []interface{}{
 	(Direction)(0),                              // 0: hyprpanel.event.v1.Direction
 	(PowerType)(0),                              // 1: hyprpanel.event.v1.PowerType
 	(PowerState)(0),                             // 2: hyprpanel.event.v1.PowerState
 	(InhibitTarget)(0),                          // 3: hyprpanel.event.v1.InhibitTarget
 	(MediaPlayerState)(0),                       // 4: hyprpanel.event.v1.MediaPlayerState
-	(EventKind)(0),                              // 5: hyprpanel.event.v1.EventKind
-	(*MediaPlayerValueChange)(nil),              // 6: hyprpanel.event.v1.MediaPlayerValueChange
-	(*HyprWorkspaceV2Value)(nil),                // 7: hyprpanel.event.v1.HyprWorkspaceV2Value
-	(*HyprDestroyWorkspaceV2Value)(nil),         // 8: hyprpanel.event.v1.HyprDestroyWorkspaceV2Value
-	(*HyprCreateWorkspaceV2Value)(nil),          // 9: hyprpanel.event.v1.HyprCreateWorkspaceV2Value
-	(*HyprMoveWindowValue)(nil),                 // 10: hyprpanel.event.v1.HyprMoveWindowValue
-	(*HyprMoveWindowV2Value)(nil),               // 11: hyprpanel.event.v1.HyprMoveWindowV2Value
-	(*HyprMoveWorkspaceValue)(nil),              // 12: hyprpanel.event.v1.HyprMoveWorkspaceValue
-	(*HyprMoveWorkspaceV2Value)(nil),            // 13: hyprpanel.event.v1.HyprMoveWorkspaceV2Value
-	(*HyprRenameWorkspaceValue)(nil),            // 14: hyprpanel.event.v1.HyprRenameWorkspaceValue
-	(*HyprActiveWindowValue)(nil),               // 15: hyprpanel.event.v1.HyprActiveWindowValue
-	(*HyprOpenWindowValue)(nil),                 // 16: hyprpanel.event.v1.HyprOpenWindowValue
-	(*StatusNotifierValue)(nil),                 // 17: hyprpanel.event.v1.StatusNotifierValue
-	(*UpdateTitleValue)(nil),                    // 18: hyprpanel.event.v1.UpdateTitleValue
-	(*UpdateTooltipValue)(nil),                  // 19: hyprpanel.event.v1.UpdateTooltipValue
-	(*UpdateIconValue)(nil),                     // 20: hyprpanel.event.v1.UpdateIconValue
-	(*UpdateStatusValue)(nil),                   // 21: hyprpanel.event.v1.UpdateStatusValue
-	(*UpdateMenuValue)(nil),                     // 22: hyprpanel.event.v1.UpdateMenuValue
-	(*NotificationValue)(nil),                   // 23: hyprpanel.event.v1.NotificationValue
-	(*HudNotificationValue)(nil),                // 24: hyprpanel.event.v1.HudNotificationValue
-	(*AudioSinkChangeValue)(nil),                // 25: hyprpanel.event.v1.AudioSinkChangeValue
-	(*AudioSourceChangeValue)(nil),              // 26: hyprpanel.event.v1.AudioSourceChangeValue
-	(*AudioSinkVolumeAdjust)(nil),               // 27: hyprpanel.event.v1.AudioSinkVolumeAdjust
-	(*AudioSinkMuteToggle)(nil),                 // 28: hyprpanel.event.v1.AudioSinkMuteToggle
-	(*AudioSourceVolumeAdjust)(nil),             // 29: hyprpanel.event.v1.AudioSourceVolumeAdjust
-	(*AudioSourceMuteToggle)(nil),               // 30: hyprpanel.event.v1.AudioSourceMuteToggle
-	(*BrightnessChangeValue)(nil),               // 31: hyprpanel.event.v1.BrightnessChangeValue
-	(*BrightnessAdjustValue)(nil),               // 32: hyprpanel.event.v1.BrightnessAdjustValue
-	(*PowerChangeValue)(nil),                    // 33: hyprpanel.event.v1.PowerChangeValue
-	(*IdleInhibitorValue)(nil),                  // 34: hyprpanel.event.v1.IdleInhibitorValue
-	(*Event)(nil),                               // 35: hyprpanel.event.v1.Event
-	(*StatusNotifierValue_Pixmap)(nil),          // 36: hyprpanel.event.v1.StatusNotifierValue.Pixmap
-	(*StatusNotifierValue_Tooltip)(nil),         // 37: hyprpanel.event.v1.StatusNotifierValue.Tooltip
-	(*StatusNotifierValue_Icon)(nil),            // 38: hyprpanel.event.v1.StatusNotifierValue.Icon
-	(*StatusNotifierValue_Menu)(nil),            // 39: hyprpanel.event.v1.StatusNotifierValue.Menu
-	(*StatusNotifierValue_Menu_Properties)(nil), // 40: hyprpanel.event.v1.StatusNotifierValue.Menu.Properties
-	(*NotificationValue_Hint)(nil),              // 41: hyprpanel.event.v1.NotificationValue.Hint
-	(*NotificationValue_Action)(nil),            // 42: hyprpanel.event.v1.NotificationValue.Action
-	(*NotificationValue_Pixmap)(nil),            // 43: hyprpanel.event.v1.NotificationValue.Pixmap
-	(*timestamppb.Timestamp)(nil),               // 44: google.protobuf.Timestamp
-	(v1.Systray_Status)(0),                      // 45: hyprpanel.module.v1.Systray.Status
-	(*durationpb.Duration)(nil),                 // 46: google.protobuf.Duration
-	(*anypb.Any)(nil),                           // 47: google.protobuf.Any
+	(ColorScheme)(0),                            // 5: hyprpanel.event.v1.ColorScheme
+	(EventKind)(0),                              // 6: hyprpanel.event.v1.EventKind
+	(*MediaPlayerValueChange)(nil),              // 7: hyprpanel.event.v1.MediaPlayerValueChange
+	(*HyprWorkspaceV2Value)(nil),                // 8: hyprpanel.event.v1.HyprWorkspaceV2Value
+	(*HyprDestroyWorkspaceV2Value)(nil),         // 9: hyprpanel.event.v1.HyprDestroyWorkspaceV2Value
+	(*HyprCreateWorkspaceV2Value)(nil),          // 10: hyprpanel.event.v1.HyprCreateWorkspaceV2Value
+	(*HyprMoveWindowValue)(nil),                 // 11: hyprpanel.event.v1.HyprMoveWindowValue
+	(*HyprMoveWindowV2Value)(nil),               // 12: hyprpanel.event.v1.HyprMoveWindowV2Value
+	(*HyprMoveWorkspaceValue)(nil),              // 13: hyprpanel.event.v1.HyprMoveWorkspaceValue
+	(*HyprMoveWorkspaceV2Value)(nil),            // 14: hyprpanel.event.v1.HyprMoveWorkspaceV2Value
+	(*HyprRenameWorkspaceValue)(nil),            // 15: hyprpanel.event.v1.HyprRenameWorkspaceValue
+	(*HyprActiveWindowValue)(nil),               // 16: hyprpanel.event.v1.HyprActiveWindowValue
+	(*HyprOpenWindowValue)(nil),                 // 17: hyprpanel.event.v1.HyprOpenWindowValue
+	(*StatusNotifierValue)(nil),                 // 18: hyprpanel.event.v1.StatusNotifierValue
+	(*UpdateTitleValue)(nil),                    // 19: hyprpanel.event.v1.UpdateTitleValue
+	(*UpdateTooltipValue)(nil),                  // 20: hyprpanel.event.v1.UpdateTooltipValue
+	(*UpdateIconValue)(nil),                     // 21: hyprpanel.event.v1.UpdateIconValue
+	(*UpdateStatusValue)(nil),                   // 22: hyprpanel.event.v1.UpdateStatusValue
+	(*UpdateMenuValue)(nil),                     // 23: hyprpanel.event.v1.UpdateMenuValue
+	(*NotificationValue)(nil),                   // 24: hyprpanel.event.v1.NotificationValue
+	(*HudNotificationValue)(nil),                // 25: hyprpanel.event.v1.HudNotificationValue
+	(*AudioSinkChangeValue)(nil),                // 26: hyprpanel.event.v1.AudioSinkChangeValue
+	(*AudioSourceChangeValue)(nil),              // 27: hyprpanel.event.v1.AudioSourceChangeValue
+	(*AudioSinkVolumeAdjust)(nil),               // 28: hyprpanel.event.v1.AudioSinkVolumeAdjust
+	(*AudioSinkMuteToggle)(nil),                 // 29: hyprpanel.event.v1.AudioSinkMuteToggle
+	(*AudioSourceVolumeAdjust)(nil),             // 30: hyprpanel.event.v1.AudioSourceVolumeAdjust
+	(*AudioSourceMuteToggle)(nil),               // 31: hyprpanel.event.v1.AudioSourceMuteToggle
+	(*BrightnessChangeValue)(nil),               // 32: hyprpanel.event.v1.BrightnessChangeValue
+	(*BrightnessAdjustValue)(nil),               // 33: hyprpanel.event.v1.BrightnessAdjustValue
+	(*PowerChangeValue)(nil),                    // 34: hyprpanel.event.v1.PowerChangeValue
+	(*IdleInhibitorValue)(nil),                  // 35: hyprpanel.event.v1.IdleInhibitorValue
+	(*ColorSchemeValue)(nil),                    // 36: hyprpanel.event.v1.ColorSchemeValue
+	(*Event)(nil),                               // 37: hyprpanel.event.v1.Event
+	(*HyprSnapshot)(nil),                        // 38: hyprpanel.event.v1.HyprSnapshot
+	(*RecordedEvent)(nil),                       // 39: hyprpanel.event.v1.RecordedEvent
+	(*StatusNotifierValue_Pixmap)(nil),          // 40: hyprpanel.event.v1.StatusNotifierValue.Pixmap
+	(*StatusNotifierValue_Tooltip)(nil),         // 41: hyprpanel.event.v1.StatusNotifierValue.Tooltip
+	(*StatusNotifierValue_Icon)(nil),            // 42: hyprpanel.event.v1.StatusNotifierValue.Icon
+	(*StatusNotifierValue_Menu)(nil),            // 43: hyprpanel.event.v1.StatusNotifierValue.Menu
+	(*StatusNotifierValue_Menu_Properties)(nil), // 44: hyprpanel.event.v1.StatusNotifierValue.Menu.Properties
+	(*NotificationValue_Hint)(nil),              // 45: hyprpanel.event.v1.NotificationValue.Hint
+	(*NotificationValue_Action)(nil),            // 46: hyprpanel.event.v1.NotificationValue.Action
+	(*NotificationValue_Pixmap)(nil),            // 47: hyprpanel.event.v1.NotificationValue.Pixmap
+	(*timestamppb.Timestamp)(nil),               // 48: google.protobuf.Timestamp
+	(v1.Systray_Status)(0),                      // 49: hyprpanel.module.v1.Systray.Status
+	(*durationpb.Duration)(nil),                 // 50: google.protobuf.Duration
+	(*anypb.Any)(nil),                           // 51: google.protobuf.Any
 }
 var file_hyprpanel_event_v1_event_proto_depIdxs = []int32{
 	4,  // 0: hyprpanel.event.v1.MediaPlayerValueChange.state:type_name -> hyprpanel.event.v1.MediaPlayerState
-	44, // 1: hyprpanel.event.v1.MediaPlayerValueChange.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 2: hyprpanel.event.v1.StatusNotifierValue.status:type_name -> hyprpanel.module.v1.Systray.Status
-	37, // 3: hyprpanel.event.v1.StatusNotifierValue.tooltip:type_name -> hyprpanel.event.v1.StatusNotifierValue.Tooltip
-	38, // 4: hyprpanel.event.v1.StatusNotifierValue.icon:type_name -> hyprpanel.event.v1.StatusNotifierValue.Icon
-	39, // 5: hyprpanel.event.v1.StatusNotifierValue.menu:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
-	37, // 6: hyprpanel.event.v1.UpdateTooltipValue.tooltip:type_name -> hyprpanel.event.v1.StatusNotifierValue.Tooltip
-	38, // 7: hyprpanel.event.v1.UpdateIconValue.icon:type_name -> hyprpanel.event.v1.StatusNotifierValue.Icon
-	45, // 8: hyprpanel.event.v1.UpdateStatusValue.status:type_name -> hyprpanel.module.v1.Systray.Status
-	39, // 9: hyprpanel.event.v1.UpdateMenuValue.menu:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
-	42, // 10: hyprpanel.event.v1.NotificationValue.actions:type_name -> hyprpanel.event.v1.NotificationValue.Action
-	41, // 11: hyprpanel.event.v1.NotificationValue.hints:type_name -> hyprpanel.event.v1.NotificationValue.Hint
-	46, // 12: hyprpanel.event.v1.NotificationValue.timeout:type_name -> google.protobuf.Duration
+	48, // 1: hyprpanel.event.v1.MediaPlayerValueChange.updated_at:type_name -> google.protobuf.Timestamp
+	49, // 2: hyprpanel.event.v1.StatusNotifierValue.status:type_name -> hyprpanel.module.v1.Systray.Status
+	41, // 3: hyprpanel.event.v1.StatusNotifierValue.tooltip:type_name -> hyprpanel.event.v1.StatusNotifierValue.Tooltip
+	42, // 4: hyprpanel.event.v1.StatusNotifierValue.icon:type_name -> hyprpanel.event.v1.StatusNotifierValue.Icon
+	43, // 5: hyprpanel.event.v1.StatusNotifierValue.menu:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
+	41, // 6: hyprpanel.event.v1.UpdateTooltipValue.tooltip:type_name -> hyprpanel.event.v1.StatusNotifierValue.Tooltip
+	42, // 7: hyprpanel.event.v1.UpdateIconValue.icon:type_name -> hyprpanel.event.v1.StatusNotifierValue.Icon
+	49, // 8: hyprpanel.event.v1.UpdateStatusValue.status:type_name -> hyprpanel.module.v1.Systray.Status
+	43, // 9: hyprpanel.event.v1.UpdateMenuValue.menu:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
+	46, // 10: hyprpanel.event.v1.NotificationValue.actions:type_name -> hyprpanel.event.v1.NotificationValue.Action
+	45, // 11: hyprpanel.event.v1.NotificationValue.hints:type_name -> hyprpanel.event.v1.NotificationValue.Hint
+	50, // 12: hyprpanel.event.v1.NotificationValue.timeout:type_name -> google.protobuf.Duration
 	0,  // 13: hyprpanel.event.v1.AudioSinkVolumeAdjust.direction:type_name -> hyprpanel.event.v1.Direction
 	0,  // 14: hyprpanel.event.v1.AudioSourceVolumeAdjust.direction:type_name -> hyprpanel.event.v1.Direction
 	0,  // 15: hyprpanel.event.v1.BrightnessAdjustValue.direction:type_name -> hyprpanel.event.v1.Direction
 	1,  // 16: hyprpanel.event.v1.PowerChangeValue.type:type_name -> hyprpanel.event.v1.PowerType
-	46, // 17: hyprpanel.event.v1.PowerChangeValue.time_to_empty:type_name -> google.protobuf.Duration
-	46, // 18: hyprpanel.event.v1.PowerChangeValue.time_to_full:type_name -> google.protobuf.Duration
+	50, // 17: hyprpanel.event.v1.PowerChangeValue.time_to_empty:type_name -> google.protobuf.Duration
+	50, // 18: hyprpanel.event.v1.PowerChangeValue.time_to_full:type_name -> google.protobuf.Duration
 	2,  // 19: hyprpanel.event.v1.PowerChangeValue.state:type_name -> hyprpanel.event.v1.PowerState
 	3,  // 20: hyprpanel.event.v1.IdleInhibitorValue.target:type_name -> hyprpanel.event.v1.InhibitTarget
-	5,  // 21: hyprpanel.event.v1.Event.kind:type_name -> hyprpanel.event.v1.EventKind
-	47, // 22: hyprpanel.event.v1.Event.data:type_name -> google.protobuf.Any
-	36, // 23: hyprpanel.event.v1.StatusNotifierValue.Tooltip.icon_pixmap:type_name -> hyprpanel.event.v1.StatusNotifierValue.Pixmap
-	36, // 24: hyprpanel.event.v1.StatusNotifierValue.Icon.icon_pixmap:type_name -> hyprpanel.event.v1.StatusNotifierValue.Pixmap
-	40, // 25: hyprpanel.event.v1.StatusNotifierValue.Menu.properties:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu.Properties
-	39, // 26: hyprpanel.event.v1.StatusNotifierValue.Menu.children:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
-	47, // 27: hyprpanel.event.v1.NotificationValue.Hint.value:type_name -> google.protobuf.Any
-	28, // [28:28] is the sub-list for method output_type
-	28, // [28:28] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	5,  // 21: hyprpanel.event.v1.ColorSchemeValue.color_scheme:type_name -> hyprpanel.event.v1.ColorScheme
+	6,  // 22: hyprpanel.event.v1.Event.kind:type_name -> hyprpanel.event.v1.EventKind
+	51, // 23: hyprpanel.event.v1.Event.data:type_name -> google.protobuf.Any
+	48, // 24: hyprpanel.event.v1.RecordedEvent.timestamp:type_name -> google.protobuf.Timestamp
+	37, // 25: hyprpanel.event.v1.RecordedEvent.event:type_name -> hyprpanel.event.v1.Event
+	38, // 26: hyprpanel.event.v1.RecordedEvent.hypr_snapshot:type_name -> hyprpanel.event.v1.HyprSnapshot
+	40, // 27: hyprpanel.event.v1.StatusNotifierValue.Tooltip.icon_pixmap:type_name -> hyprpanel.event.v1.StatusNotifierValue.Pixmap
+	40, // 28: hyprpanel.event.v1.StatusNotifierValue.Icon.icon_pixmap:type_name -> hyprpanel.event.v1.StatusNotifierValue.Pixmap
+	44, // 29: hyprpanel.event.v1.StatusNotifierValue.Menu.properties:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu.Properties
+	43, // 30: hyprpanel.event.v1.StatusNotifierValue.Menu.children:type_name -> hyprpanel.event.v1.StatusNotifierValue.Menu
+	51, // 31: hyprpanel.event.v1.NotificationValue.Hint.value:type_name -> google.protobuf.Any
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_hyprpanel_event_v1_event_proto_init() }
@@ -4248,7 +4539,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			}
 		}
 		file_hyprpanel_event_v1_event_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+			switch v := v.(*ColorSchemeValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4260,7 +4551,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			}
 		}
 		file_hyprpanel_event_v1_event_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusNotifierValue_Pixmap); i {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4272,7 +4563,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			}
 		}
 		file_hyprpanel_event_v1_event_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusNotifierValue_Tooltip); i {
+			switch v := v.(*HyprSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4284,7 +4575,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			}
 		}
 		file_hyprpanel_event_v1_event_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusNotifierValue_Icon); i {
+			switch v := v.(*RecordedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4296,7 +4587,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			}
 		}
 		file_hyprpanel_event_v1_event_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusNotifierValue_Menu); i {
+			switch v := v.(*StatusNotifierValue_Pixmap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4308,7 +4599,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			}
 		}
 		file_hyprpanel_event_v1_event_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusNotifierValue_Menu_Properties); i {
+			switch v := v.(*StatusNotifierValue_Tooltip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4320,7 +4611,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			}
 		}
 		file_hyprpanel_event_v1_event_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationValue_Hint); i {
+			switch v := v.(*StatusNotifierValue_Icon); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4332,7 +4623,7 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			}
 		}
 		file_hyprpanel_event_v1_event_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationValue_Action); i {
+			switch v := v.(*StatusNotifierValue_Menu); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4344,6 +4635,42 @@ func file_hyprpanel_event_v1_event_proto_init() {
 			}
 		}
 		file_hyprpanel_event_v1_event_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusNotifierValue_Menu_Properties); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationValue_Hint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationValue_Action); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_event_v1_event_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationValue_Pixmap); i {
 			case 0:
 				return &v.state
@@ -4362,8 +4689,8 @@ func file_hyprpanel_event_v1_event_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hyprpanel_event_v1_event_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   38,
+			NumEnums:      7,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,6 +3,7 @@ package eventv1
 import (
 	"errors"
 	"strconv"
+	"strings"
 
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -103,3 +104,16 @@ func DataUInt32(a *anypb.Any) (uint32, error) {
 	}
 	return v.Value, nil
 }
+
+// ParseEventKind convenience function for resolving an event kind by name, with or without the EVENT_KIND_ prefix.
+func ParseEventKind(name string) (EventKind, bool) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, `EVENT_KIND_`) {
+		name = `EVENT_KIND_` + name
+	}
+	v, ok := EventKind_value[name]
+	if !ok || v == int32(EventKind_EVENT_KIND_UNSPECIFIED) {
+		return EventKind_EVENT_KIND_UNSPECIFIED, false
+	}
+	return EventKind(v), true
+}
@@ -7,8 +7,9 @@
 package hyprpanelv1
 
 import (
-	v1 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
-	v11 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	v11 "github.com/pdf/hyprpanel/proto/hyprpanel/config/v1"
+	v1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	v12 "github.com/pdf/hyprpanel/proto/hyprpanel/widget/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
@@ -173,6 +174,64 @@ func (NotificationClosedReason) EnumDescriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{2}
 }
 
+type HyprQuery int32
+
+const (
+	HyprQuery_HYPR_QUERY_UNSPECIFIED      HyprQuery = 0
+	HyprQuery_HYPR_QUERY_CLIENTS          HyprQuery = 1
+	HyprQuery_HYPR_QUERY_MONITORS         HyprQuery = 2
+	HyprQuery_HYPR_QUERY_WORKSPACES       HyprQuery = 3
+	HyprQuery_HYPR_QUERY_ACTIVE_WINDOW    HyprQuery = 4
+	HyprQuery_HYPR_QUERY_ACTIVE_WORKSPACE HyprQuery = 5
+)
+
+// Enum value maps for HyprQuery.
+var (
+	HyprQuery_name = map[int32]string{
+		0: "HYPR_QUERY_UNSPECIFIED",
+		1: "HYPR_QUERY_CLIENTS",
+		2: "HYPR_QUERY_MONITORS",
+		3: "HYPR_QUERY_WORKSPACES",
+		4: "HYPR_QUERY_ACTIVE_WINDOW",
+		5: "HYPR_QUERY_ACTIVE_WORKSPACE",
+	}
+	HyprQuery_value = map[string]int32{
+		"HYPR_QUERY_UNSPECIFIED":      0,
+		"HYPR_QUERY_CLIENTS":          1,
+		"HYPR_QUERY_MONITORS":         2,
+		"HYPR_QUERY_WORKSPACES":       3,
+		"HYPR_QUERY_ACTIVE_WINDOW":    4,
+		"HYPR_QUERY_ACTIVE_WORKSPACE": 5,
+	}
+)
+
+func (x HyprQuery) Enum() *HyprQuery {
+	p := new(HyprQuery)
+	*p = x
+	return p
+}
+
+func (x HyprQuery) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HyprQuery) Descriptor() protoreflect.EnumDescriptor {
+	return file_hyprpanel_v1_hyprpanel_proto_enumTypes[3].Descriptor()
+}
+
+func (HyprQuery) Type() protoreflect.EnumType {
+	return &file_hyprpanel_v1_hyprpanel_proto_enumTypes[3]
+}
+
+func (x HyprQuery) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HyprQuery.Descriptor instead.
+func (HyprQuery) EnumDescriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{3}
+}
+
 type ImageNRGBA struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -363,20 +422,19 @@ func (x *AppInfo) GetActions() []*AppInfo_Action {
 	return nil
 }
 
-type PanelServiceInitRequest struct {
+type Capabilities struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Host       uint32      `protobuf:"varint,1,opt,name=host,proto3" json:"host,omitempty"`
-	Id         string      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	LogLevel   v1.LogLevel `protobuf:"varint,3,opt,name=log_level,json=logLevel,proto3,enum=hyprpanel.config.v1.LogLevel" json:"log_level,omitempty"`
-	Config     *v1.Panel   `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	Stylesheet []byte      `protobuf:"bytes,5,opt,name=stylesheet,proto3" json:"stylesheet,omitempty"`
+	Version    string         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Methods    []string       `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	EventKinds []v1.EventKind `protobuf:"varint,4,rep,packed,name=event_kinds,json=eventKinds,proto3,enum=hyprpanel.event.v1.EventKind" json:"event_kinds,omitempty"`
+	Modules    []string       `protobuf:"bytes,5,rep,name=modules,proto3" json:"modules,omitempty"`
 }
 
-func (x *PanelServiceInitRequest) Reset() {
-	*x = PanelServiceInitRequest{}
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -384,13 +442,13 @@ func (x *PanelServiceInitRequest) Reset() {
 	}
 }
 
-func (x *PanelServiceInitRequest) String() string {
+func (x *Capabilities) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceInitRequest) ProtoMessage() {}
+func (*Capabilities) ProtoMessage() {}
 
-func (x *PanelServiceInitRequest) ProtoReflect() protoreflect.Message {
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -402,54 +460,54 @@ func (x *PanelServiceInitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceInitRequest.ProtoReflect.Descriptor instead.
-func (*PanelServiceInitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PanelServiceInitRequest) GetHost() uint32 {
-	if x != nil {
-		return x.Host
-	}
-	return 0
-}
-
-func (x *PanelServiceInitRequest) GetId() string {
+func (x *Capabilities) GetVersion() string {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
 	return ""
 }
 
-func (x *PanelServiceInitRequest) GetLogLevel() v1.LogLevel {
+func (x *Capabilities) GetMethods() []string {
 	if x != nil {
-		return x.LogLevel
+		return x.Methods
 	}
-	return v1.LogLevel(0)
+	return nil
 }
 
-func (x *PanelServiceInitRequest) GetConfig() *v1.Panel {
+func (x *Capabilities) GetEventKinds() []v1.EventKind {
 	if x != nil {
-		return x.Config
+		return x.EventKinds
 	}
 	return nil
 }
 
-func (x *PanelServiceInitRequest) GetStylesheet() []byte {
+func (x *Capabilities) GetModules() []string {
 	if x != nil {
-		return x.Stylesheet
+		return x.Modules
 	}
 	return nil
 }
 
-type PanelServiceInitResponse struct {
+type PanelServiceInitRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Host         uint32        `protobuf:"varint,1,opt,name=host,proto3" json:"host,omitempty"`
+	Id           string        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	LogLevel     v11.LogLevel  `protobuf:"varint,3,opt,name=log_level,json=logLevel,proto3,enum=hyprpanel.config.v1.LogLevel" json:"log_level,omitempty"`
+	Config       *v11.Panel    `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	Stylesheet   []byte        `protobuf:"bytes,5,opt,name=stylesheet,proto3" json:"stylesheet,omitempty"`
+	Capabilities *Capabilities `protobuf:"bytes,6,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
-func (x *PanelServiceInitResponse) Reset() {
-	*x = PanelServiceInitResponse{}
+func (x *PanelServiceInitRequest) Reset() {
+	*x = PanelServiceInitRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -457,13 +515,13 @@ func (x *PanelServiceInitResponse) Reset() {
 	}
 }
 
-func (x *PanelServiceInitResponse) String() string {
+func (x *PanelServiceInitRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceInitResponse) ProtoMessage() {}
+func (*PanelServiceInitRequest) ProtoMessage() {}
 
-func (x *PanelServiceInitResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceInitRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -475,21 +533,64 @@ func (x *PanelServiceInitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceInitResponse.ProtoReflect.Descriptor instead.
-func (*PanelServiceInitResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceInitRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceInitRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{3}
 }
 
-type PanelServiceNotifyRequest struct {
+func (x *PanelServiceInitRequest) GetHost() uint32 {
+	if x != nil {
+		return x.Host
+	}
+	return 0
+}
+
+func (x *PanelServiceInitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PanelServiceInitRequest) GetLogLevel() v11.LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return v11.LogLevel(0)
+}
+
+func (x *PanelServiceInitRequest) GetConfig() *v11.Panel {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PanelServiceInitRequest) GetStylesheet() []byte {
+	if x != nil {
+		return x.Stylesheet
+	}
+	return nil
+}
+
+func (x *PanelServiceInitRequest) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type PanelServiceInitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event *v11.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	EventFilter  *EventFilter  `protobuf:"bytes,1,opt,name=event_filter,json=eventFilter,proto3" json:"event_filter,omitempty"`
+	Capabilities *Capabilities `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
-func (x *PanelServiceNotifyRequest) Reset() {
-	*x = PanelServiceNotifyRequest{}
+func (x *PanelServiceInitResponse) Reset() {
+	*x = PanelServiceInitResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,13 +598,13 @@ func (x *PanelServiceNotifyRequest) Reset() {
 	}
 }
 
-func (x *PanelServiceNotifyRequest) String() string {
+func (x *PanelServiceInitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceNotifyRequest) ProtoMessage() {}
+func (*PanelServiceInitResponse) ProtoMessage() {}
 
-func (x *PanelServiceNotifyRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceInitResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,26 +616,35 @@ func (x *PanelServiceNotifyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceNotifyRequest.ProtoReflect.Descriptor instead.
-func (*PanelServiceNotifyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceInitResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceInitResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PanelServiceNotifyRequest) GetEvent() *v11.Event {
+func (x *PanelServiceInitResponse) GetEventFilter() *EventFilter {
 	if x != nil {
-		return x.Event
+		return x.EventFilter
 	}
 	return nil
 }
 
-type PanelServiceNotifyResponse struct {
+func (x *PanelServiceInitResponse) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type EventFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Kinds []v1.EventKind `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=hyprpanel.event.v1.EventKind" json:"kinds,omitempty"`
 }
 
-func (x *PanelServiceNotifyResponse) Reset() {
-	*x = PanelServiceNotifyResponse{}
+func (x *EventFilter) Reset() {
+	*x = EventFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,13 +652,13 @@ func (x *PanelServiceNotifyResponse) Reset() {
 	}
 }
 
-func (x *PanelServiceNotifyResponse) String() string {
+func (x *EventFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceNotifyResponse) ProtoMessage() {}
+func (*EventFilter) ProtoMessage() {}
 
-func (x *PanelServiceNotifyResponse) ProtoReflect() protoreflect.Message {
+func (x *EventFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -560,21 +670,28 @@ func (x *PanelServiceNotifyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceNotifyResponse.ProtoReflect.Descriptor instead.
-func (*PanelServiceNotifyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventFilter.ProtoReflect.Descriptor instead.
+func (*EventFilter) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{5}
 }
 
-type PanelServiceNotificationCloseRequest struct {
+func (x *EventFilter) GetKinds() []v1.EventKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type PanelServiceNotifyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event *v1.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *PanelServiceNotificationCloseRequest) Reset() {
-	*x = PanelServiceNotificationCloseRequest{}
+func (x *PanelServiceNotifyRequest) Reset() {
+	*x = PanelServiceNotifyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -582,13 +699,13 @@ func (x *PanelServiceNotificationCloseRequest) Reset() {
 	}
 }
 
-func (x *PanelServiceNotificationCloseRequest) String() string {
+func (x *PanelServiceNotifyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceNotificationCloseRequest) ProtoMessage() {}
+func (*PanelServiceNotifyRequest) ProtoMessage() {}
 
-func (x *PanelServiceNotificationCloseRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceNotifyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -600,26 +717,26 @@ func (x *PanelServiceNotificationCloseRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceNotificationCloseRequest.ProtoReflect.Descriptor instead.
-func (*PanelServiceNotificationCloseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceNotifyRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceNotifyRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PanelServiceNotificationCloseRequest) GetId() uint32 {
+func (x *PanelServiceNotifyRequest) GetEvent() *v1.Event {
 	if x != nil {
-		return x.Id
+		return x.Event
 	}
-	return 0
+	return nil
 }
 
-type PanelServiceNotificationCloseResponse struct {
+type PanelServiceNotifyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PanelServiceNotificationCloseResponse) Reset() {
-	*x = PanelServiceNotificationCloseResponse{}
+func (x *PanelServiceNotifyResponse) Reset() {
+	*x = PanelServiceNotifyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -627,13 +744,13 @@ func (x *PanelServiceNotificationCloseResponse) Reset() {
 	}
 }
 
-func (x *PanelServiceNotificationCloseResponse) String() string {
+func (x *PanelServiceNotifyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceNotificationCloseResponse) ProtoMessage() {}
+func (*PanelServiceNotifyResponse) ProtoMessage() {}
 
-func (x *PanelServiceNotificationCloseResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceNotifyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,19 +762,21 @@ func (x *PanelServiceNotificationCloseResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceNotificationCloseResponse.ProtoReflect.Descriptor instead.
-func (*PanelServiceNotificationCloseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceNotifyResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceNotifyResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{7}
 }
 
-type PanelServiceCloseRequest struct {
+type PanelServiceNotifyStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Events []*v1.Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *PanelServiceCloseRequest) Reset() {
-	*x = PanelServiceCloseRequest{}
+func (x *PanelServiceNotifyStreamRequest) Reset() {
+	*x = PanelServiceNotifyStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -665,13 +784,13 @@ func (x *PanelServiceCloseRequest) Reset() {
 	}
 }
 
-func (x *PanelServiceCloseRequest) String() string {
+func (x *PanelServiceNotifyStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceCloseRequest) ProtoMessage() {}
+func (*PanelServiceNotifyStreamRequest) ProtoMessage() {}
 
-func (x *PanelServiceCloseRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceNotifyStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -683,19 +802,26 @@ func (x *PanelServiceCloseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceCloseRequest.ProtoReflect.Descriptor instead.
-func (*PanelServiceCloseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceNotifyStreamRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceNotifyStreamRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{8}
 }
 
-type PanelServiceCloseResponse struct {
+func (x *PanelServiceNotifyStreamRequest) GetEvents() []*v1.Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type PanelServiceNotifyStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PanelServiceCloseResponse) Reset() {
-	*x = PanelServiceCloseResponse{}
+func (x *PanelServiceNotifyStreamResponse) Reset() {
+	*x = PanelServiceNotifyStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -703,13 +829,13 @@ func (x *PanelServiceCloseResponse) Reset() {
 	}
 }
 
-func (x *PanelServiceCloseResponse) String() string {
+func (x *PanelServiceNotifyStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PanelServiceCloseResponse) ProtoMessage() {}
+func (*PanelServiceNotifyStreamResponse) ProtoMessage() {}
 
-func (x *PanelServiceCloseResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceNotifyStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,21 +847,21 @@ func (x *PanelServiceCloseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PanelServiceCloseResponse.ProtoReflect.Descriptor instead.
-func (*PanelServiceCloseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceNotifyStreamResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceNotifyStreamResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{9}
 }
 
-type HostServiceExecRequest struct {
+type PanelServiceUpdateStyleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action *AppInfo_Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Stylesheet []byte `protobuf:"bytes,1,opt,name=stylesheet,proto3" json:"stylesheet,omitempty"`
 }
 
-func (x *HostServiceExecRequest) Reset() {
-	*x = HostServiceExecRequest{}
+func (x *PanelServiceUpdateStyleRequest) Reset() {
+	*x = PanelServiceUpdateStyleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -743,13 +869,13 @@ func (x *HostServiceExecRequest) Reset() {
 	}
 }
 
-func (x *HostServiceExecRequest) String() string {
+func (x *PanelServiceUpdateStyleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceExecRequest) ProtoMessage() {}
+func (*PanelServiceUpdateStyleRequest) ProtoMessage() {}
 
-func (x *HostServiceExecRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceUpdateStyleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -761,26 +887,26 @@ func (x *HostServiceExecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceExecRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceExecRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceUpdateStyleRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceUpdateStyleRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *HostServiceExecRequest) GetAction() *AppInfo_Action {
+func (x *PanelServiceUpdateStyleRequest) GetStylesheet() []byte {
 	if x != nil {
-		return x.Action
+		return x.Stylesheet
 	}
 	return nil
 }
 
-type HostServiceExecResponse struct {
+type PanelServiceUpdateStyleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceExecResponse) Reset() {
-	*x = HostServiceExecResponse{}
+func (x *PanelServiceUpdateStyleResponse) Reset() {
+	*x = PanelServiceUpdateStyleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,13 +914,13 @@ func (x *HostServiceExecResponse) Reset() {
 	}
 }
 
-func (x *HostServiceExecResponse) String() string {
+func (x *PanelServiceUpdateStyleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceExecResponse) ProtoMessage() {}
+func (*PanelServiceUpdateStyleResponse) ProtoMessage() {}
 
-func (x *HostServiceExecResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceUpdateStyleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -806,21 +932,21 @@ func (x *HostServiceExecResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceExecResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceExecResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceUpdateStyleResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceUpdateStyleResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{11}
 }
 
-type HostServiceFindApplicationRequest struct {
+type PanelServiceNotificationCloseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *HostServiceFindApplicationRequest) Reset() {
-	*x = HostServiceFindApplicationRequest{}
+func (x *PanelServiceNotificationCloseRequest) Reset() {
+	*x = PanelServiceNotificationCloseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -828,13 +954,13 @@ func (x *HostServiceFindApplicationRequest) Reset() {
 	}
 }
 
-func (x *HostServiceFindApplicationRequest) String() string {
+func (x *PanelServiceNotificationCloseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceFindApplicationRequest) ProtoMessage() {}
+func (*PanelServiceNotificationCloseRequest) ProtoMessage() {}
 
-func (x *HostServiceFindApplicationRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceNotificationCloseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -846,28 +972,26 @@ func (x *HostServiceFindApplicationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceFindApplicationRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceFindApplicationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceNotificationCloseRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceNotificationCloseRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *HostServiceFindApplicationRequest) GetQuery() string {
+func (x *PanelServiceNotificationCloseRequest) GetId() uint32 {
 	if x != nil {
-		return x.Query
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type HostServiceFindApplicationResponse struct {
+type PanelServiceNotificationCloseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AppInfo *AppInfo `protobuf:"bytes,1,opt,name=app_info,json=appInfo,proto3" json:"app_info,omitempty"`
 }
 
-func (x *HostServiceFindApplicationResponse) Reset() {
-	*x = HostServiceFindApplicationResponse{}
+func (x *PanelServiceNotificationCloseResponse) Reset() {
+	*x = PanelServiceNotificationCloseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -875,13 +999,13 @@ func (x *HostServiceFindApplicationResponse) Reset() {
 	}
 }
 
-func (x *HostServiceFindApplicationResponse) String() string {
+func (x *PanelServiceNotificationCloseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceFindApplicationResponse) ProtoMessage() {}
+func (*PanelServiceNotificationCloseResponse) ProtoMessage() {}
 
-func (x *HostServiceFindApplicationResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceNotificationCloseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,30 +1017,19 @@ func (x *HostServiceFindApplicationResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceFindApplicationResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceFindApplicationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceNotificationCloseResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceNotificationCloseResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *HostServiceFindApplicationResponse) GetAppInfo() *AppInfo {
-	if x != nil {
-		return x.AppInfo
-	}
-	return nil
-}
-
-type HostServiceSystrayActivateRequest struct {
+type PanelServiceCloseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	BusName string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
-	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *HostServiceSystrayActivateRequest) Reset() {
-	*x = HostServiceSystrayActivateRequest{}
+func (x *PanelServiceCloseRequest) Reset() {
+	*x = PanelServiceCloseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,13 +1037,13 @@ func (x *HostServiceSystrayActivateRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayActivateRequest) String() string {
+func (x *PanelServiceCloseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayActivateRequest) ProtoMessage() {}
+func (*PanelServiceCloseRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayActivateRequest) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceCloseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,40 +1055,19 @@ func (x *HostServiceSystrayActivateRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayActivateRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayActivateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceCloseRequest.ProtoReflect.Descriptor instead.
+func (*PanelServiceCloseRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *HostServiceSystrayActivateRequest) GetBusName() string {
-	if x != nil {
-		return x.BusName
-	}
-	return ""
-}
-
-func (x *HostServiceSystrayActivateRequest) GetX() int32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *HostServiceSystrayActivateRequest) GetY() int32 {
-	if x != nil {
-		return x.Y
-	}
-	return 0
-}
-
-type HostServiceSystrayActivateResponse struct {
+type PanelServiceCloseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystrayActivateResponse) Reset() {
-	*x = HostServiceSystrayActivateResponse{}
+func (x *PanelServiceCloseResponse) Reset() {
+	*x = PanelServiceCloseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -983,13 +1075,13 @@ func (x *HostServiceSystrayActivateResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayActivateResponse) String() string {
+func (x *PanelServiceCloseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayActivateResponse) ProtoMessage() {}
+func (*PanelServiceCloseResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayActivateResponse) ProtoReflect() protoreflect.Message {
+func (x *PanelServiceCloseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1001,23 +1093,21 @@ func (x *HostServiceSystrayActivateResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayActivateResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayActivateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PanelServiceCloseResponse.ProtoReflect.Descriptor instead.
+func (*PanelServiceCloseResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{15}
 }
 
-type HostServiceSystraySecondaryActivateRequest struct {
+type HostServiceExecRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusName string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
-	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
+	Action *AppInfo_Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
 }
 
-func (x *HostServiceSystraySecondaryActivateRequest) Reset() {
-	*x = HostServiceSystraySecondaryActivateRequest{}
+func (x *HostServiceExecRequest) Reset() {
+	*x = HostServiceExecRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,13 +1115,13 @@ func (x *HostServiceSystraySecondaryActivateRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystraySecondaryActivateRequest) String() string {
+func (x *HostServiceExecRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystraySecondaryActivateRequest) ProtoMessage() {}
+func (*HostServiceExecRequest) ProtoMessage() {}
 
-func (x *HostServiceSystraySecondaryActivateRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceExecRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,40 +1133,26 @@ func (x *HostServiceSystraySecondaryActivateRequest) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystraySecondaryActivateRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystraySecondaryActivateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceExecRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceExecRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *HostServiceSystraySecondaryActivateRequest) GetBusName() string {
-	if x != nil {
-		return x.BusName
-	}
-	return ""
-}
-
-func (x *HostServiceSystraySecondaryActivateRequest) GetX() int32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *HostServiceSystraySecondaryActivateRequest) GetY() int32 {
+func (x *HostServiceExecRequest) GetAction() *AppInfo_Action {
 	if x != nil {
-		return x.Y
+		return x.Action
 	}
-	return 0
+	return nil
 }
 
-type HostServiceSystraySecondaryActivateResponse struct {
+type HostServiceExecResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystraySecondaryActivateResponse) Reset() {
-	*x = HostServiceSystraySecondaryActivateResponse{}
+func (x *HostServiceExecResponse) Reset() {
+	*x = HostServiceExecResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1084,13 +1160,13 @@ func (x *HostServiceSystraySecondaryActivateResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystraySecondaryActivateResponse) String() string {
+func (x *HostServiceExecResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystraySecondaryActivateResponse) ProtoMessage() {}
+func (*HostServiceExecResponse) ProtoMessage() {}
 
-func (x *HostServiceSystraySecondaryActivateResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceExecResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1102,23 +1178,21 @@ func (x *HostServiceSystraySecondaryActivateResponse) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystraySecondaryActivateResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystraySecondaryActivateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceExecResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceExecResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{17}
 }
 
-type HostServiceSystrayScrollRequest struct {
+type HostServiceFindApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusName     string                   `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	Delta       int32                    `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
-	Orientation SystrayScrollOrientation `protobuf:"varint,3,opt,name=orientation,proto3,enum=hyprpanel.v1.SystrayScrollOrientation" json:"orientation,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *HostServiceSystrayScrollRequest) Reset() {
-	*x = HostServiceSystrayScrollRequest{}
+func (x *HostServiceFindApplicationRequest) Reset() {
+	*x = HostServiceFindApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1126,13 +1200,13 @@ func (x *HostServiceSystrayScrollRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayScrollRequest) String() string {
+func (x *HostServiceFindApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayScrollRequest) ProtoMessage() {}
+func (*HostServiceFindApplicationRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayScrollRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceFindApplicationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1144,40 +1218,28 @@ func (x *HostServiceSystrayScrollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayScrollRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayScrollRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceFindApplicationRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceFindApplicationRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *HostServiceSystrayScrollRequest) GetBusName() string {
+func (x *HostServiceFindApplicationRequest) GetQuery() string {
 	if x != nil {
-		return x.BusName
+		return x.Query
 	}
 	return ""
 }
 
-func (x *HostServiceSystrayScrollRequest) GetDelta() int32 {
-	if x != nil {
-		return x.Delta
-	}
-	return 0
-}
-
-func (x *HostServiceSystrayScrollRequest) GetOrientation() SystrayScrollOrientation {
-	if x != nil {
-		return x.Orientation
-	}
-	return SystrayScrollOrientation_SYSTRAY_SCROLL_ORIENTATION_UNSPECIFIED
-}
-
-type HostServiceSystrayScrollResponse struct {
+type HostServiceFindApplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AppInfo *AppInfo `protobuf:"bytes,1,opt,name=app_info,json=appInfo,proto3" json:"app_info,omitempty"`
 }
 
-func (x *HostServiceSystrayScrollResponse) Reset() {
-	*x = HostServiceSystrayScrollResponse{}
+func (x *HostServiceFindApplicationResponse) Reset() {
+	*x = HostServiceFindApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1185,13 +1247,13 @@ func (x *HostServiceSystrayScrollResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayScrollResponse) String() string {
+func (x *HostServiceFindApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayScrollResponse) ProtoMessage() {}
+func (*HostServiceFindApplicationResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayScrollResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceFindApplicationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1203,12 +1265,19 @@ func (x *HostServiceSystrayScrollResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayScrollResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayScrollResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceFindApplicationResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceFindApplicationResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{19}
 }
 
-type HostServiceSystrayMenuContextActivateRequest struct {
+func (x *HostServiceFindApplicationResponse) GetAppInfo() *AppInfo {
+	if x != nil {
+		return x.AppInfo
+	}
+	return nil
+}
+
+type HostServiceSystrayActivateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1218,8 +1287,8 @@ type HostServiceSystrayMenuContextActivateRequest struct {
 	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) Reset() {
-	*x = HostServiceSystrayMenuContextActivateRequest{}
+func (x *HostServiceSystrayActivateRequest) Reset() {
+	*x = HostServiceSystrayActivateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1227,13 +1296,13 @@ func (x *HostServiceSystrayMenuContextActivateRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) String() string {
+func (x *HostServiceSystrayActivateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuContextActivateRequest) ProtoMessage() {}
+func (*HostServiceSystrayActivateRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuContextActivateRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayActivateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1245,40 +1314,40 @@ func (x *HostServiceSystrayMenuContextActivateRequest) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuContextActivateRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuContextActivateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayActivateRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayActivateRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) GetBusName() string {
+func (x *HostServiceSystrayActivateRequest) GetBusName() string {
 	if x != nil {
 		return x.BusName
 	}
 	return ""
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) GetX() int32 {
+func (x *HostServiceSystrayActivateRequest) GetX() int32 {
 	if x != nil {
 		return x.X
 	}
 	return 0
 }
 
-func (x *HostServiceSystrayMenuContextActivateRequest) GetY() int32 {
+func (x *HostServiceSystrayActivateRequest) GetY() int32 {
 	if x != nil {
 		return x.Y
 	}
 	return 0
 }
 
-type HostServiceSystrayMenuContextActivateResponse struct {
+type HostServiceSystrayActivateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystrayMenuContextActivateResponse) Reset() {
-	*x = HostServiceSystrayMenuContextActivateResponse{}
+func (x *HostServiceSystrayActivateResponse) Reset() {
+	*x = HostServiceSystrayActivateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1286,13 +1355,13 @@ func (x *HostServiceSystrayMenuContextActivateResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuContextActivateResponse) String() string {
+func (x *HostServiceSystrayActivateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuContextActivateResponse) ProtoMessage() {}
+func (*HostServiceSystrayActivateResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuContextActivateResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayActivateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1304,22 +1373,23 @@ func (x *HostServiceSystrayMenuContextActivateResponse) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuContextActivateResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuContextActivateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayActivateResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayActivateResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{21}
 }
 
-type HostServiceSystrayMenuAboutToShowRequest struct {
+type HostServiceSystraySecondaryActivateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusName    string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	MenuItemId string `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
+	BusName string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) Reset() {
-	*x = HostServiceSystrayMenuAboutToShowRequest{}
+func (x *HostServiceSystraySecondaryActivateRequest) Reset() {
+	*x = HostServiceSystraySecondaryActivateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1327,13 +1397,13 @@ func (x *HostServiceSystrayMenuAboutToShowRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) String() string {
+func (x *HostServiceSystraySecondaryActivateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuAboutToShowRequest) ProtoMessage() {}
+func (*HostServiceSystraySecondaryActivateRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystraySecondaryActivateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1345,33 +1415,40 @@ func (x *HostServiceSystrayMenuAboutToShowRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuAboutToShowRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuAboutToShowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystraySecondaryActivateRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystraySecondaryActivateRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) GetBusName() string {
+func (x *HostServiceSystraySecondaryActivateRequest) GetBusName() string {
 	if x != nil {
 		return x.BusName
 	}
 	return ""
 }
 
-func (x *HostServiceSystrayMenuAboutToShowRequest) GetMenuItemId() string {
+func (x *HostServiceSystraySecondaryActivateRequest) GetX() int32 {
 	if x != nil {
-		return x.MenuItemId
+		return x.X
 	}
-	return ""
+	return 0
 }
 
-type HostServiceSystrayMenuAboutToShowResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *HostServiceSystraySecondaryActivateRequest) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type HostServiceSystraySecondaryActivateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystrayMenuAboutToShowResponse) Reset() {
-	*x = HostServiceSystrayMenuAboutToShowResponse{}
+func (x *HostServiceSystraySecondaryActivateResponse) Reset() {
+	*x = HostServiceSystraySecondaryActivateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1379,13 +1456,13 @@ func (x *HostServiceSystrayMenuAboutToShowResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuAboutToShowResponse) String() string {
+func (x *HostServiceSystraySecondaryActivateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuAboutToShowResponse) ProtoMessage() {}
+func (*HostServiceSystraySecondaryActivateResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuAboutToShowResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystraySecondaryActivateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1397,25 +1474,23 @@ func (x *HostServiceSystrayMenuAboutToShowResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuAboutToShowResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuAboutToShowResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystraySecondaryActivateResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystraySecondaryActivateResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{23}
 }
 
-type HostServiceSystrayMenuEventRequest struct {
+type HostServiceSystrayScrollRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BusName   string           `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
-	Id        int32            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	EventId   SystrayMenuEvent `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3,enum=hyprpanel.v1.SystrayMenuEvent" json:"event_id,omitempty"`
-	Data      *anypb.Any       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	Timestamp uint32           `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BusName     string                   `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	Delta       int32                    `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Orientation SystrayScrollOrientation `protobuf:"varint,3,opt,name=orientation,proto3,enum=hyprpanel.v1.SystrayScrollOrientation" json:"orientation,omitempty"`
 }
 
-func (x *HostServiceSystrayMenuEventRequest) Reset() {
-	*x = HostServiceSystrayMenuEventRequest{}
+func (x *HostServiceSystrayScrollRequest) Reset() {
+	*x = HostServiceSystrayScrollRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1423,13 +1498,13 @@ func (x *HostServiceSystrayMenuEventRequest) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuEventRequest) String() string {
+func (x *HostServiceSystrayScrollRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuEventRequest) ProtoMessage() {}
+func (*HostServiceSystrayScrollRequest) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuEventRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayScrollRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1441,54 +1516,40 @@ func (x *HostServiceSystrayMenuEventRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuEventRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayScrollRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayScrollRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *HostServiceSystrayMenuEventRequest) GetBusName() string {
+func (x *HostServiceSystrayScrollRequest) GetBusName() string {
 	if x != nil {
 		return x.BusName
 	}
 	return ""
 }
 
-func (x *HostServiceSystrayMenuEventRequest) GetId() int32 {
+func (x *HostServiceSystrayScrollRequest) GetDelta() int32 {
 	if x != nil {
-		return x.Id
+		return x.Delta
 	}
 	return 0
 }
 
-func (x *HostServiceSystrayMenuEventRequest) GetEventId() SystrayMenuEvent {
-	if x != nil {
-		return x.EventId
-	}
-	return SystrayMenuEvent_SYSTRAY_MENU_EVENT_UNSPECIFIED
-}
-
-func (x *HostServiceSystrayMenuEventRequest) GetData() *anypb.Any {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *HostServiceSystrayMenuEventRequest) GetTimestamp() uint32 {
+func (x *HostServiceSystrayScrollRequest) GetOrientation() SystrayScrollOrientation {
 	if x != nil {
-		return x.Timestamp
+		return x.Orientation
 	}
-	return 0
+	return SystrayScrollOrientation_SYSTRAY_SCROLL_ORIENTATION_UNSPECIFIED
 }
 
-type HostServiceSystrayMenuEventResponse struct {
+type HostServiceSystrayScrollResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceSystrayMenuEventResponse) Reset() {
-	*x = HostServiceSystrayMenuEventResponse{}
+func (x *HostServiceSystrayScrollResponse) Reset() {
+	*x = HostServiceSystrayScrollResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1496,13 +1557,13 @@ func (x *HostServiceSystrayMenuEventResponse) Reset() {
 	}
 }
 
-func (x *HostServiceSystrayMenuEventResponse) String() string {
+func (x *HostServiceSystrayScrollResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceSystrayMenuEventResponse) ProtoMessage() {}
+func (*HostServiceSystrayScrollResponse) ProtoMessage() {}
 
-func (x *HostServiceSystrayMenuEventResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayScrollResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1514,22 +1575,23 @@ func (x *HostServiceSystrayMenuEventResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceSystrayMenuEventResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceSystrayMenuEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayScrollResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayScrollResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{25}
 }
 
-type HostServiceNotificationClosedRequest struct {
+type HostServiceSystrayMenuContextActivateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     uint32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Reason NotificationClosedReason `protobuf:"varint,2,opt,name=reason,proto3,enum=hyprpanel.v1.NotificationClosedReason" json:"reason,omitempty"`
+	BusName string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	X       int32  `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y       int32  `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *HostServiceNotificationClosedRequest) Reset() {
-	*x = HostServiceNotificationClosedRequest{}
+func (x *HostServiceSystrayMenuContextActivateRequest) Reset() {
+	*x = HostServiceSystrayMenuContextActivateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1537,13 +1599,13 @@ func (x *HostServiceNotificationClosedRequest) Reset() {
 	}
 }
 
-func (x *HostServiceNotificationClosedRequest) String() string {
+func (x *HostServiceSystrayMenuContextActivateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceNotificationClosedRequest) ProtoMessage() {}
+func (*HostServiceSystrayMenuContextActivateRequest) ProtoMessage() {}
 
-func (x *HostServiceNotificationClosedRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuContextActivateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1555,33 +1617,40 @@ func (x *HostServiceNotificationClosedRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceNotificationClosedRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceNotificationClosedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuContextActivateRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuContextActivateRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *HostServiceNotificationClosedRequest) GetId() uint32 {
+func (x *HostServiceSystrayMenuContextActivateRequest) GetBusName() string {
 	if x != nil {
-		return x.Id
+		return x.BusName
+	}
+	return ""
+}
+
+func (x *HostServiceSystrayMenuContextActivateRequest) GetX() int32 {
+	if x != nil {
+		return x.X
 	}
 	return 0
 }
 
-func (x *HostServiceNotificationClosedRequest) GetReason() NotificationClosedReason {
+func (x *HostServiceSystrayMenuContextActivateRequest) GetY() int32 {
 	if x != nil {
-		return x.Reason
+		return x.Y
 	}
-	return NotificationClosedReason_NOTIFICATION_CLOSED_REASON_UNSPECIFIED
+	return 0
 }
 
-type HostServiceNotificationClosedResponse struct {
+type HostServiceSystrayMenuContextActivateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceNotificationClosedResponse) Reset() {
-	*x = HostServiceNotificationClosedResponse{}
+func (x *HostServiceSystrayMenuContextActivateResponse) Reset() {
+	*x = HostServiceSystrayMenuContextActivateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1589,13 +1658,13 @@ func (x *HostServiceNotificationClosedResponse) Reset() {
 	}
 }
 
-func (x *HostServiceNotificationClosedResponse) String() string {
+func (x *HostServiceSystrayMenuContextActivateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceNotificationClosedResponse) ProtoMessage() {}
+func (*HostServiceSystrayMenuContextActivateResponse) ProtoMessage() {}
 
-func (x *HostServiceNotificationClosedResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuContextActivateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1607,22 +1676,22 @@ func (x *HostServiceNotificationClosedResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceNotificationClosedResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceNotificationClosedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuContextActivateResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuContextActivateResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{27}
 }
 
-type HostServiceNotificationActionRequest struct {
+type HostServiceSystrayMenuAboutToShowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ActionKey string `protobuf:"bytes,2,opt,name=action_key,json=actionKey,proto3" json:"action_key,omitempty"`
+	BusName    string `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	MenuItemId string `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
 }
 
-func (x *HostServiceNotificationActionRequest) Reset() {
-	*x = HostServiceNotificationActionRequest{}
+func (x *HostServiceSystrayMenuAboutToShowRequest) Reset() {
+	*x = HostServiceSystrayMenuAboutToShowRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1630,13 +1699,13 @@ func (x *HostServiceNotificationActionRequest) Reset() {
 	}
 }
 
-func (x *HostServiceNotificationActionRequest) String() string {
+func (x *HostServiceSystrayMenuAboutToShowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceNotificationActionRequest) ProtoMessage() {}
+func (*HostServiceSystrayMenuAboutToShowRequest) ProtoMessage() {}
 
-func (x *HostServiceNotificationActionRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuAboutToShowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1648,33 +1717,33 @@ func (x *HostServiceNotificationActionRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceNotificationActionRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceNotificationActionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuAboutToShowRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuAboutToShowRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *HostServiceNotificationActionRequest) GetId() uint32 {
+func (x *HostServiceSystrayMenuAboutToShowRequest) GetBusName() string {
 	if x != nil {
-		return x.Id
+		return x.BusName
 	}
-	return 0
+	return ""
 }
 
-func (x *HostServiceNotificationActionRequest) GetActionKey() string {
+func (x *HostServiceSystrayMenuAboutToShowRequest) GetMenuItemId() string {
 	if x != nil {
-		return x.ActionKey
+		return x.MenuItemId
 	}
 	return ""
 }
 
-type HostServiceNotificationActionResponse struct {
+type HostServiceSystrayMenuAboutToShowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceNotificationActionResponse) Reset() {
-	*x = HostServiceNotificationActionResponse{}
+func (x *HostServiceSystrayMenuAboutToShowResponse) Reset() {
+	*x = HostServiceSystrayMenuAboutToShowResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1682,13 +1751,13 @@ func (x *HostServiceNotificationActionResponse) Reset() {
 	}
 }
 
-func (x *HostServiceNotificationActionResponse) String() string {
+func (x *HostServiceSystrayMenuAboutToShowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceNotificationActionResponse) ProtoMessage() {}
+func (*HostServiceSystrayMenuAboutToShowResponse) ProtoMessage() {}
 
-func (x *HostServiceNotificationActionResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuAboutToShowResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1700,22 +1769,25 @@ func (x *HostServiceNotificationActionResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceNotificationActionResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceNotificationActionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuAboutToShowResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuAboutToShowResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{29}
 }
 
-type HostServiceAudioSinkVolumeAdjustRequest struct {
+type HostServiceSystrayMenuEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Direction v11.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
+	BusName   string           `protobuf:"bytes,1,opt,name=bus_name,json=busName,proto3" json:"bus_name,omitempty"`
+	Id        int32            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	EventId   SystrayMenuEvent `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3,enum=hyprpanel.v1.SystrayMenuEvent" json:"event_id,omitempty"`
+	Data      *anypb.Any       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp uint32           `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) Reset() {
-	*x = HostServiceAudioSinkVolumeAdjustRequest{}
+func (x *HostServiceSystrayMenuEventRequest) Reset() {
+	*x = HostServiceSystrayMenuEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1723,13 +1795,13 @@ func (x *HostServiceAudioSinkVolumeAdjustRequest) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) String() string {
+func (x *HostServiceSystrayMenuEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSinkVolumeAdjustRequest) ProtoMessage() {}
+func (*HostServiceSystrayMenuEventRequest) ProtoMessage() {}
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1741,33 +1813,54 @@ func (x *HostServiceAudioSinkVolumeAdjustRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSinkVolumeAdjustRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSinkVolumeAdjustRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuEventRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuEventRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) GetId() string {
+func (x *HostServiceSystrayMenuEventRequest) GetBusName() string {
 	if x != nil {
-		return x.Id
+		return x.BusName
 	}
 	return ""
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustRequest) GetDirection() v11.Direction {
+func (x *HostServiceSystrayMenuEventRequest) GetId() int32 {
 	if x != nil {
-		return x.Direction
+		return x.Id
 	}
-	return v11.Direction(0)
+	return 0
 }
 
-type HostServiceAudioSinkVolumeAdjustResponse struct {
+func (x *HostServiceSystrayMenuEventRequest) GetEventId() SystrayMenuEvent {
+	if x != nil {
+		return x.EventId
+	}
+	return SystrayMenuEvent_SYSTRAY_MENU_EVENT_UNSPECIFIED
+}
+
+func (x *HostServiceSystrayMenuEventRequest) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *HostServiceSystrayMenuEventRequest) GetTimestamp() uint32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type HostServiceSystrayMenuEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustResponse) Reset() {
-	*x = HostServiceAudioSinkVolumeAdjustResponse{}
+func (x *HostServiceSystrayMenuEventResponse) Reset() {
+	*x = HostServiceSystrayMenuEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1775,13 +1868,13 @@ func (x *HostServiceAudioSinkVolumeAdjustResponse) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSinkVolumeAdjustResponse) String() string {
+func (x *HostServiceSystrayMenuEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSinkVolumeAdjustResponse) ProtoMessage() {}
+func (*HostServiceSystrayMenuEventResponse) ProtoMessage() {}
 
-func (x *HostServiceAudioSinkVolumeAdjustResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceSystrayMenuEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1793,21 +1886,22 @@ func (x *HostServiceAudioSinkVolumeAdjustResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSinkVolumeAdjustResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSinkVolumeAdjustResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceSystrayMenuEventResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceSystrayMenuEventResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{31}
 }
 
-type HostServiceAudioSinkMuteToggleRequest struct {
+type HostServiceNotificationClosedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id     uint32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason NotificationClosedReason `protobuf:"varint,2,opt,name=reason,proto3,enum=hyprpanel.v1.NotificationClosedReason" json:"reason,omitempty"`
 }
 
-func (x *HostServiceAudioSinkMuteToggleRequest) Reset() {
-	*x = HostServiceAudioSinkMuteToggleRequest{}
+func (x *HostServiceNotificationClosedRequest) Reset() {
+	*x = HostServiceNotificationClosedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1815,13 +1909,13 @@ func (x *HostServiceAudioSinkMuteToggleRequest) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSinkMuteToggleRequest) String() string {
+func (x *HostServiceNotificationClosedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSinkMuteToggleRequest) ProtoMessage() {}
+func (*HostServiceNotificationClosedRequest) ProtoMessage() {}
 
-func (x *HostServiceAudioSinkMuteToggleRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceNotificationClosedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1833,26 +1927,33 @@ func (x *HostServiceAudioSinkMuteToggleRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSinkMuteToggleRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSinkMuteToggleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceNotificationClosedRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceNotificationClosedRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *HostServiceAudioSinkMuteToggleRequest) GetId() string {
+func (x *HostServiceNotificationClosedRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type HostServiceAudioSinkMuteToggleResponse struct {
+func (x *HostServiceNotificationClosedRequest) GetReason() NotificationClosedReason {
+	if x != nil {
+		return x.Reason
+	}
+	return NotificationClosedReason_NOTIFICATION_CLOSED_REASON_UNSPECIFIED
+}
+
+type HostServiceNotificationClosedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceAudioSinkMuteToggleResponse) Reset() {
-	*x = HostServiceAudioSinkMuteToggleResponse{}
+func (x *HostServiceNotificationClosedResponse) Reset() {
+	*x = HostServiceNotificationClosedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1860,13 +1961,13 @@ func (x *HostServiceAudioSinkMuteToggleResponse) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSinkMuteToggleResponse) String() string {
+func (x *HostServiceNotificationClosedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSinkMuteToggleResponse) ProtoMessage() {}
+func (*HostServiceNotificationClosedResponse) ProtoMessage() {}
 
-func (x *HostServiceAudioSinkMuteToggleResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceNotificationClosedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1878,22 +1979,22 @@ func (x *HostServiceAudioSinkMuteToggleResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSinkMuteToggleResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSinkMuteToggleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceNotificationClosedResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceNotificationClosedResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{33}
 }
 
-type HostServiceAudioSourceVolumeAdjustRequest struct {
+type HostServiceNotificationActionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Direction v11.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
+	Id        uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionKey string `protobuf:"bytes,2,opt,name=action_key,json=actionKey,proto3" json:"action_key,omitempty"`
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) Reset() {
-	*x = HostServiceAudioSourceVolumeAdjustRequest{}
+func (x *HostServiceNotificationActionRequest) Reset() {
+	*x = HostServiceNotificationActionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1901,13 +2002,13 @@ func (x *HostServiceAudioSourceVolumeAdjustRequest) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) String() string {
+func (x *HostServiceNotificationActionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSourceVolumeAdjustRequest) ProtoMessage() {}
+func (*HostServiceNotificationActionRequest) ProtoMessage() {}
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceNotificationActionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1919,33 +2020,33 @@ func (x *HostServiceAudioSourceVolumeAdjustRequest) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSourceVolumeAdjustRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSourceVolumeAdjustRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceNotificationActionRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceNotificationActionRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) GetId() string {
+func (x *HostServiceNotificationActionRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustRequest) GetDirection() v11.Direction {
+func (x *HostServiceNotificationActionRequest) GetActionKey() string {
 	if x != nil {
-		return x.Direction
+		return x.ActionKey
 	}
-	return v11.Direction(0)
+	return ""
 }
 
-type HostServiceAudioSourceVolumeAdjustResponse struct {
+type HostServiceNotificationActionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustResponse) Reset() {
-	*x = HostServiceAudioSourceVolumeAdjustResponse{}
+func (x *HostServiceNotificationActionResponse) Reset() {
+	*x = HostServiceNotificationActionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1953,13 +2054,13 @@ func (x *HostServiceAudioSourceVolumeAdjustResponse) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSourceVolumeAdjustResponse) String() string {
+func (x *HostServiceNotificationActionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSourceVolumeAdjustResponse) ProtoMessage() {}
+func (*HostServiceNotificationActionResponse) ProtoMessage() {}
 
-func (x *HostServiceAudioSourceVolumeAdjustResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceNotificationActionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1971,21 +2072,22 @@ func (x *HostServiceAudioSourceVolumeAdjustResponse) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSourceVolumeAdjustResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSourceVolumeAdjustResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceNotificationActionResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceNotificationActionResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{35}
 }
 
-type HostServiceAudioSourceMuteToggleRequest struct {
+type HostServiceAudioSinkVolumeAdjustRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id        string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Direction v1.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
 }
 
-func (x *HostServiceAudioSourceMuteToggleRequest) Reset() {
-	*x = HostServiceAudioSourceMuteToggleRequest{}
+func (x *HostServiceAudioSinkVolumeAdjustRequest) Reset() {
+	*x = HostServiceAudioSinkVolumeAdjustRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1993,13 +2095,13 @@ func (x *HostServiceAudioSourceMuteToggleRequest) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSourceMuteToggleRequest) String() string {
+func (x *HostServiceAudioSinkVolumeAdjustRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSourceMuteToggleRequest) ProtoMessage() {}
+func (*HostServiceAudioSinkVolumeAdjustRequest) ProtoMessage() {}
 
-func (x *HostServiceAudioSourceMuteToggleRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSinkVolumeAdjustRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2011,26 +2113,33 @@ func (x *HostServiceAudioSourceMuteToggleRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSourceMuteToggleRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSourceMuteToggleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSinkVolumeAdjustRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSinkVolumeAdjustRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *HostServiceAudioSourceMuteToggleRequest) GetId() string {
+func (x *HostServiceAudioSinkVolumeAdjustRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type HostServiceAudioSourceMuteToggleResponse struct {
+func (x *HostServiceAudioSinkVolumeAdjustRequest) GetDirection() v1.Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return v1.Direction(0)
+}
+
+type HostServiceAudioSinkVolumeAdjustResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceAudioSourceMuteToggleResponse) Reset() {
-	*x = HostServiceAudioSourceMuteToggleResponse{}
+func (x *HostServiceAudioSinkVolumeAdjustResponse) Reset() {
+	*x = HostServiceAudioSinkVolumeAdjustResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2038,13 +2147,13 @@ func (x *HostServiceAudioSourceMuteToggleResponse) Reset() {
 	}
 }
 
-func (x *HostServiceAudioSourceMuteToggleResponse) String() string {
+func (x *HostServiceAudioSinkVolumeAdjustResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceAudioSourceMuteToggleResponse) ProtoMessage() {}
+func (*HostServiceAudioSinkVolumeAdjustResponse) ProtoMessage() {}
 
-func (x *HostServiceAudioSourceMuteToggleResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSinkVolumeAdjustResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2056,22 +2165,21 @@ func (x *HostServiceAudioSourceMuteToggleResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceAudioSourceMuteToggleResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceAudioSourceMuteToggleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSinkVolumeAdjustResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSinkVolumeAdjustResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{37}
 }
 
-type HostServiceBrightnessAdjustRequest struct {
+type HostServiceAudioSinkMuteToggleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DevName   string        `protobuf:"bytes,1,opt,name=dev_name,json=devName,proto3" json:"dev_name,omitempty"`
-	Direction v11.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *HostServiceBrightnessAdjustRequest) Reset() {
-	*x = HostServiceBrightnessAdjustRequest{}
+func (x *HostServiceAudioSinkMuteToggleRequest) Reset() {
+	*x = HostServiceAudioSinkMuteToggleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2079,13 +2187,13 @@ func (x *HostServiceBrightnessAdjustRequest) Reset() {
 	}
 }
 
-func (x *HostServiceBrightnessAdjustRequest) String() string {
+func (x *HostServiceAudioSinkMuteToggleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceBrightnessAdjustRequest) ProtoMessage() {}
+func (*HostServiceAudioSinkMuteToggleRequest) ProtoMessage() {}
 
-func (x *HostServiceBrightnessAdjustRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSinkMuteToggleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2097,33 +2205,26 @@ func (x *HostServiceBrightnessAdjustRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceBrightnessAdjustRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceBrightnessAdjustRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSinkMuteToggleRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSinkMuteToggleRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *HostServiceBrightnessAdjustRequest) GetDevName() string {
+func (x *HostServiceAudioSinkMuteToggleRequest) GetId() string {
 	if x != nil {
-		return x.DevName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *HostServiceBrightnessAdjustRequest) GetDirection() v11.Direction {
-	if x != nil {
-		return x.Direction
-	}
-	return v11.Direction(0)
-}
-
-type HostServiceBrightnessAdjustResponse struct {
+type HostServiceAudioSinkMuteToggleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HostServiceBrightnessAdjustResponse) Reset() {
-	*x = HostServiceBrightnessAdjustResponse{}
+func (x *HostServiceAudioSinkMuteToggleResponse) Reset() {
+	*x = HostServiceAudioSinkMuteToggleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2131,13 +2232,13 @@ func (x *HostServiceBrightnessAdjustResponse) Reset() {
 	}
 }
 
-func (x *HostServiceBrightnessAdjustResponse) String() string {
+func (x *HostServiceAudioSinkMuteToggleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceBrightnessAdjustResponse) ProtoMessage() {}
+func (*HostServiceAudioSinkMuteToggleResponse) ProtoMessage() {}
 
-func (x *HostServiceBrightnessAdjustResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSinkMuteToggleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2149,23 +2250,22 @@ func (x *HostServiceBrightnessAdjustResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceBrightnessAdjustResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceBrightnessAdjustResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSinkMuteToggleResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSinkMuteToggleResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{39}
 }
 
-type HostServiceCaptureFrameRequest struct {
+type HostServiceAudioSourceVolumeAdjustRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address uint64 `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
-	Width   int32  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
-	Height  int32  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Id        string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Direction v1.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
 }
 
-func (x *HostServiceCaptureFrameRequest) Reset() {
-	*x = HostServiceCaptureFrameRequest{}
+func (x *HostServiceAudioSourceVolumeAdjustRequest) Reset() {
+	*x = HostServiceAudioSourceVolumeAdjustRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2173,13 +2273,13 @@ func (x *HostServiceCaptureFrameRequest) Reset() {
 	}
 }
 
-func (x *HostServiceCaptureFrameRequest) String() string {
+func (x *HostServiceAudioSourceVolumeAdjustRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceCaptureFrameRequest) ProtoMessage() {}
+func (*HostServiceAudioSourceVolumeAdjustRequest) ProtoMessage() {}
 
-func (x *HostServiceCaptureFrameRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSourceVolumeAdjustRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2191,42 +2291,33 @@ func (x *HostServiceCaptureFrameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceCaptureFrameRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceCaptureFrameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSourceVolumeAdjustRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSourceVolumeAdjustRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *HostServiceCaptureFrameRequest) GetAddress() uint64 {
-	if x != nil {
-		return x.Address
-	}
-	return 0
-}
-
-func (x *HostServiceCaptureFrameRequest) GetWidth() int32 {
+func (x *HostServiceAudioSourceVolumeAdjustRequest) GetId() string {
 	if x != nil {
-		return x.Width
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *HostServiceCaptureFrameRequest) GetHeight() int32 {
+func (x *HostServiceAudioSourceVolumeAdjustRequest) GetDirection() v1.Direction {
 	if x != nil {
-		return x.Height
+		return x.Direction
 	}
-	return 0
+	return v1.Direction(0)
 }
 
-type HostServiceIdleInhibitorRequest struct {
+type HostServiceAudioSourceVolumeAdjustResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Target v11.InhibitTarget `protobuf:"varint,1,opt,name=target,proto3,enum=hyprpanel.event.v1.InhibitTarget" json:"target,omitempty"`
 }
 
-func (x *HostServiceIdleInhibitorRequest) Reset() {
-	*x = HostServiceIdleInhibitorRequest{}
+func (x *HostServiceAudioSourceVolumeAdjustResponse) Reset() {
+	*x = HostServiceAudioSourceVolumeAdjustResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2234,13 +2325,13 @@ func (x *HostServiceIdleInhibitorRequest) Reset() {
 	}
 }
 
-func (x *HostServiceIdleInhibitorRequest) String() string {
+func (x *HostServiceAudioSourceVolumeAdjustResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceIdleInhibitorRequest) ProtoMessage() {}
+func (*HostServiceAudioSourceVolumeAdjustResponse) ProtoMessage() {}
 
-func (x *HostServiceIdleInhibitorRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSourceVolumeAdjustResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2252,26 +2343,21 @@ func (x *HostServiceIdleInhibitorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceIdleInhibitorRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceIdleInhibitorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSourceVolumeAdjustResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSourceVolumeAdjustResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *HostServiceIdleInhibitorRequest) GetTarget() v11.InhibitTarget {
-	if x != nil {
-		return x.Target
-	}
-	return v11.InhibitTarget(0)
-}
-
-type HostServiceIdleInhibitorResponse struct {
+type HostServiceAudioSourceMuteToggleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *HostServiceIdleInhibitorResponse) Reset() {
-	*x = HostServiceIdleInhibitorResponse{}
+func (x *HostServiceAudioSourceMuteToggleRequest) Reset() {
+	*x = HostServiceAudioSourceMuteToggleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2279,13 +2365,13 @@ func (x *HostServiceIdleInhibitorResponse) Reset() {
 	}
 }
 
-func (x *HostServiceIdleInhibitorResponse) String() string {
+func (x *HostServiceAudioSourceMuteToggleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceIdleInhibitorResponse) ProtoMessage() {}
+func (*HostServiceAudioSourceMuteToggleRequest) ProtoMessage() {}
 
-func (x *HostServiceIdleInhibitorResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSourceMuteToggleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2297,21 +2383,26 @@ func (x *HostServiceIdleInhibitorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceIdleInhibitorResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceIdleInhibitorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSourceMuteToggleRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSourceMuteToggleRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{42}
 }
 
-type HostServiceCaptureFrameResponse struct {
+func (x *HostServiceAudioSourceMuteToggleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type HostServiceAudioSourceMuteToggleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Image *ImageNRGBA `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-}
-
-func (x *HostServiceCaptureFrameResponse) Reset() {
-	*x = HostServiceCaptureFrameResponse{}
+func (x *HostServiceAudioSourceMuteToggleResponse) Reset() {
+	*x = HostServiceAudioSourceMuteToggleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2319,13 +2410,13 @@ func (x *HostServiceCaptureFrameResponse) Reset() {
 	}
 }
 
-func (x *HostServiceCaptureFrameResponse) String() string {
+func (x *HostServiceAudioSourceMuteToggleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceCaptureFrameResponse) ProtoMessage() {}
+func (*HostServiceAudioSourceMuteToggleResponse) ProtoMessage() {}
 
-func (x *HostServiceCaptureFrameResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceAudioSourceMuteToggleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2337,26 +2428,22 @@ func (x *HostServiceCaptureFrameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceCaptureFrameResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceCaptureFrameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceAudioSourceMuteToggleResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceAudioSourceMuteToggleResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *HostServiceCaptureFrameResponse) GetImage() *ImageNRGBA {
-	if x != nil {
-		return x.Image
-	}
-	return nil
-}
-
-type HostServiceMediaPlayerRequest struct {
+type HostServiceBrightnessAdjustRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DevName   string       `protobuf:"bytes,1,opt,name=dev_name,json=devName,proto3" json:"dev_name,omitempty"`
+	Direction v1.Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
 }
 
-func (x *HostServiceMediaPlayerRequest) Reset() {
-	*x = HostServiceMediaPlayerRequest{}
+func (x *HostServiceBrightnessAdjustRequest) Reset() {
+	*x = HostServiceBrightnessAdjustRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2364,13 +2451,13 @@ func (x *HostServiceMediaPlayerRequest) Reset() {
 	}
 }
 
-func (x *HostServiceMediaPlayerRequest) String() string {
+func (x *HostServiceBrightnessAdjustRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceMediaPlayerRequest) ProtoMessage() {}
+func (*HostServiceBrightnessAdjustRequest) ProtoMessage() {}
 
-func (x *HostServiceMediaPlayerRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceBrightnessAdjustRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2382,21 +2469,33 @@ func (x *HostServiceMediaPlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceMediaPlayerRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceMediaPlayerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceBrightnessAdjustRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceBrightnessAdjustRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{44}
 }
 
-type HostServiceMediaPlayerSeekRequest struct {
+func (x *HostServiceBrightnessAdjustRequest) GetDevName() string {
+	if x != nil {
+		return x.DevName
+	}
+	return ""
+}
+
+func (x *HostServiceBrightnessAdjustRequest) GetDirection() v1.Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return v1.Direction(0)
+}
+
+type HostServiceBrightnessAdjustResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	OffsetUs int64 `protobuf:"varint,1,opt,name=offset_us,json=offsetUs,proto3" json:"offset_us,omitempty"`
 }
 
-func (x *HostServiceMediaPlayerSeekRequest) Reset() {
-	*x = HostServiceMediaPlayerSeekRequest{}
+func (x *HostServiceBrightnessAdjustResponse) Reset() {
+	*x = HostServiceBrightnessAdjustResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2404,13 +2503,13 @@ func (x *HostServiceMediaPlayerSeekRequest) Reset() {
 	}
 }
 
-func (x *HostServiceMediaPlayerSeekRequest) String() string {
+func (x *HostServiceBrightnessAdjustResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceMediaPlayerSeekRequest) ProtoMessage() {}
+func (*HostServiceBrightnessAdjustResponse) ProtoMessage() {}
 
-func (x *HostServiceMediaPlayerSeekRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceBrightnessAdjustResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2422,29 +2521,23 @@ func (x *HostServiceMediaPlayerSeekRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceMediaPlayerSeekRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceMediaPlayerSeekRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceBrightnessAdjustResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceBrightnessAdjustResponse) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *HostServiceMediaPlayerSeekRequest) GetOffsetUs() int64 {
-	if x != nil {
-		return x.OffsetUs
-	}
-	return 0
-}
-
-type HostServiceMediaPlayerSetPostionRequest struct {
+type HostServiceCaptureFrameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TrackId    string `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
-	PositionUs int64  `protobuf:"varint,2,opt,name=position_us,json=positionUs,proto3" json:"position_us,omitempty"`
+	Address uint64 `protobuf:"varint,1,opt,name=address,proto3" json:"address,omitempty"`
+	Width   int32  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height  int32  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *HostServiceMediaPlayerSetPostionRequest) Reset() {
-	*x = HostServiceMediaPlayerSetPostionRequest{}
+func (x *HostServiceCaptureFrameRequest) Reset() {
+	*x = HostServiceCaptureFrameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2452,13 +2545,13 @@ func (x *HostServiceMediaPlayerSetPostionRequest) Reset() {
 	}
 }
 
-func (x *HostServiceMediaPlayerSetPostionRequest) String() string {
+func (x *HostServiceCaptureFrameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceMediaPlayerSetPostionRequest) ProtoMessage() {}
+func (*HostServiceCaptureFrameRequest) ProtoMessage() {}
 
-func (x *HostServiceMediaPlayerSetPostionRequest) ProtoReflect() protoreflect.Message {
+func (x *HostServiceCaptureFrameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2470,33 +2563,42 @@ func (x *HostServiceMediaPlayerSetPostionRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceMediaPlayerSetPostionRequest.ProtoReflect.Descriptor instead.
-func (*HostServiceMediaPlayerSetPostionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceCaptureFrameRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceCaptureFrameRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *HostServiceMediaPlayerSetPostionRequest) GetTrackId() string {
+func (x *HostServiceCaptureFrameRequest) GetAddress() uint64 {
 	if x != nil {
-		return x.TrackId
+		return x.Address
 	}
-	return ""
+	return 0
 }
 
-func (x *HostServiceMediaPlayerSetPostionRequest) GetPositionUs() int64 {
+func (x *HostServiceCaptureFrameRequest) GetWidth() int32 {
 	if x != nil {
-		return x.PositionUs
+		return x.Width
 	}
 	return 0
 }
 
-type HostServiceMediaPlayerResponse struct {
+func (x *HostServiceCaptureFrameRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type HostServiceIdleInhibitorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Target v1.InhibitTarget `protobuf:"varint,1,opt,name=target,proto3,enum=hyprpanel.event.v1.InhibitTarget" json:"target,omitempty"`
 }
 
-func (x *HostServiceMediaPlayerResponse) Reset() {
-	*x = HostServiceMediaPlayerResponse{}
+func (x *HostServiceIdleInhibitorRequest) Reset() {
+	*x = HostServiceIdleInhibitorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2504,13 +2606,13 @@ func (x *HostServiceMediaPlayerResponse) Reset() {
 	}
 }
 
-func (x *HostServiceMediaPlayerResponse) String() string {
+func (x *HostServiceIdleInhibitorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HostServiceMediaPlayerResponse) ProtoMessage() {}
+func (*HostServiceIdleInhibitorRequest) ProtoMessage() {}
 
-func (x *HostServiceMediaPlayerResponse) ProtoReflect() protoreflect.Message {
+func (x *HostServiceIdleInhibitorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2522,24 +2624,26 @@ func (x *HostServiceMediaPlayerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HostServiceMediaPlayerResponse.ProtoReflect.Descriptor instead.
-func (*HostServiceMediaPlayerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HostServiceIdleInhibitorRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceIdleInhibitorRequest) Descriptor() ([]byte, []int) {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{47}
 }
 
-type AppInfo_Action struct {
+func (x *HostServiceIdleInhibitorRequest) GetTarget() v1.InhibitTarget {
+	if x != nil {
+		return x.Target
+	}
+	return v1.InhibitTarget(0)
+}
+
+type HostServiceIdleInhibitorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Icon    string   `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
-	Exec    []string `protobuf:"bytes,3,rep,name=exec,proto3" json:"exec,omitempty"`
-	RawExec string   `protobuf:"bytes,4,opt,name=raw_exec,json=rawExec,proto3" json:"raw_exec,omitempty"`
 }
 
-func (x *AppInfo_Action) Reset() {
-	*x = AppInfo_Action{}
+func (x *HostServiceIdleInhibitorResponse) Reset() {
+	*x = HostServiceIdleInhibitorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2547,13 +2651,13 @@ func (x *AppInfo_Action) Reset() {
 	}
 }
 
-func (x *AppInfo_Action) String() string {
+func (x *HostServiceIdleInhibitorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppInfo_Action) ProtoMessage() {}
+func (*HostServiceIdleInhibitorResponse) ProtoMessage() {}
 
-func (x *AppInfo_Action) ProtoReflect() protoreflect.Message {
+func (x *HostServiceIdleInhibitorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2565,549 +2669,1945 @@ func (x *AppInfo_Action) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppInfo_Action.ProtoReflect.Descriptor instead.
-func (*AppInfo_Action) Descriptor() ([]byte, []int) {
-	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use HostServiceIdleInhibitorResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceIdleInhibitorResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *AppInfo_Action) GetName() string {
-	if x != nil {
-		return x.Name
+type HostServiceCaptureFrameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image *ImageNRGBA `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *HostServiceCaptureFrameResponse) Reset() {
+	*x = HostServiceCaptureFrameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AppInfo_Action) GetIcon() string {
-	if x != nil {
-		return x.Icon
+func (x *HostServiceCaptureFrameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceCaptureFrameResponse) ProtoMessage() {}
+
+func (x *HostServiceCaptureFrameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AppInfo_Action) GetExec() []string {
+// Deprecated: Use HostServiceCaptureFrameResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceCaptureFrameResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *HostServiceCaptureFrameResponse) GetImage() *ImageNRGBA {
 	if x != nil {
-		return x.Exec
+		return x.Image
 	}
 	return nil
 }
 
-func (x *AppInfo_Action) GetRawExec() string {
-	if x != nil {
-		return x.RawExec
+type HostServiceMediaPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HostServiceMediaPlayerRequest) Reset() {
+	*x = HostServiceMediaPlayerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-var File_hyprpanel_v1_hyprpanel_proto protoreflect.FileDescriptor
+func (x *HostServiceMediaPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_hyprpanel_v1_hyprpanel_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x68,
-	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
-	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x68, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6a, 0x0a, 0x0a, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x4e, 0x52, 0x47, 0x42, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x78, 0x65, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x91, 0x03, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x69, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
-	0x74, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x74, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x72,
-	0x61, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
-	0x61, 0x77, 0x45, 0x78, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x75, 0x70, 0x5f, 0x77, 0x6d, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x57, 0x6d, 0x43,
-	0x6c, 0x61, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
-	0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5f, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78,
-	0x65, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x19,
-	0x0a, 0x08, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x72, 0x61, 0x77, 0x45, 0x78, 0x65, 0x63, 0x22, 0xcd, 0x01, 0x0a, 0x17, 0x50, 0x61,
-	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x6f, 0x67,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68,
-	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65,
-	0x6c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x79,
-	0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73,
-	0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x50, 0x61, 0x6e,
-	0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x19, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x36, 0x0a, 0x24, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x25, 0x50, 0x61, 0x6e,
-	0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b,
-	0x0a, 0x19, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x21, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x22, 0x56, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x07, 0x61, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x5a, 0x0a, 0x21, 0x48, 0x6f, 0x73,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x24, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x2a, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61,
-	0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79,
-	0x22, 0x2d, 0x0a, 0x2b, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x9c, 0x01, 0x0a, 0x1f, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64,
-	0x65, 0x6c, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
-	0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22,
-	0x0a, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73,
-	0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x65, 0x0a, 0x2c, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a,
-	0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x2f, 0x0a, 0x2d, 0x48, 0x6f, 0x73,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d,
-	0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x28, 0x48, 0x6f,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
-	0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
-	0x6d, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x29, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f,
-	0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xd2, 0x01, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x25, 0x0a, 0x23, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x24,
-	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x25, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a,
-	0x24, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4b, 0x65, 0x79, 0x22, 0x27, 0x0a, 0x25, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a,
-	0x27, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79,
-	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x28, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x37, 0x0a, 0x25, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x26, 0x48, 0x6f,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69,
-	0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x29, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c,
-	0x0a, 0x2a, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64,
-	0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x27,
-	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x28, 0x48, 0x6f, 0x73, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x76,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x25, 0x0a, 0x23, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x1e, 0x48, 0x6f, 0x73, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72,
-	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x22, 0x5c, 0x0a, 0x1f, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x68, 0x69, 0x62,
-	0x69, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x22, 0x22, 0x0a, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x1f, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x52, 0x47, 0x42, 0x41,
-	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x48, 0x6f, 0x73, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x21, 0x48, 0x6f, 0x73, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x55, 0x73, 0x22, 0x65, 0x0a, 0x27, 0x48, 0x6f,
+func (*HostServiceMediaPlayerRequest) ProtoMessage() {}
+
+func (x *HostServiceMediaPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceMediaPlayerRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceMediaPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{50}
+}
+
+type HostServiceMediaPlayerSeekRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OffsetUs int64 `protobuf:"varint,1,opt,name=offset_us,json=offsetUs,proto3" json:"offset_us,omitempty"`
+}
+
+func (x *HostServiceMediaPlayerSeekRequest) Reset() {
+	*x = HostServiceMediaPlayerSeekRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceMediaPlayerSeekRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceMediaPlayerSeekRequest) ProtoMessage() {}
+
+func (x *HostServiceMediaPlayerSeekRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceMediaPlayerSeekRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceMediaPlayerSeekRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *HostServiceMediaPlayerSeekRequest) GetOffsetUs() int64 {
+	if x != nil {
+		return x.OffsetUs
+	}
+	return 0
+}
+
+type HostServiceMediaPlayerSetPostionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId    string `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	PositionUs int64  `protobuf:"varint,2,opt,name=position_us,json=positionUs,proto3" json:"position_us,omitempty"`
+}
+
+func (x *HostServiceMediaPlayerSetPostionRequest) Reset() {
+	*x = HostServiceMediaPlayerSetPostionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceMediaPlayerSetPostionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceMediaPlayerSetPostionRequest) ProtoMessage() {}
+
+func (x *HostServiceMediaPlayerSetPostionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceMediaPlayerSetPostionRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceMediaPlayerSetPostionRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *HostServiceMediaPlayerSetPostionRequest) GetTrackId() string {
+	if x != nil {
+		return x.TrackId
+	}
+	return ""
+}
+
+func (x *HostServiceMediaPlayerSetPostionRequest) GetPositionUs() int64 {
+	if x != nil {
+		return x.PositionUs
+	}
+	return 0
+}
+
+type HostServiceMediaPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HostServiceMediaPlayerResponse) Reset() {
+	*x = HostServiceMediaPlayerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceMediaPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceMediaPlayerResponse) ProtoMessage() {}
+
+func (x *HostServiceMediaPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceMediaPlayerResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceMediaPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{53}
+}
+
+type HostServiceHyprQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query HyprQuery `protobuf:"varint,1,opt,name=query,proto3,enum=hyprpanel.v1.HyprQuery" json:"query,omitempty"`
+}
+
+func (x *HostServiceHyprQueryRequest) Reset() {
+	*x = HostServiceHyprQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceHyprQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceHyprQueryRequest) ProtoMessage() {}
+
+func (x *HostServiceHyprQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceHyprQueryRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceHyprQueryRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *HostServiceHyprQueryRequest) GetQuery() HyprQuery {
+	if x != nil {
+		return x.Query
+	}
+	return HyprQuery_HYPR_QUERY_UNSPECIFIED
+}
+
+type HostServiceHyprQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Json []byte `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *HostServiceHyprQueryResponse) Reset() {
+	*x = HostServiceHyprQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceHyprQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceHyprQueryResponse) ProtoMessage() {}
+
+func (x *HostServiceHyprQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceHyprQueryResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceHyprQueryResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *HostServiceHyprQueryResponse) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+type HostServiceHyprDispatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Args []string `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *HostServiceHyprDispatchRequest) Reset() {
+	*x = HostServiceHyprDispatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceHyprDispatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceHyprDispatchRequest) ProtoMessage() {}
+
+func (x *HostServiceHyprDispatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceHyprDispatchRequest.ProtoReflect.Descriptor instead.
+func (*HostServiceHyprDispatchRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *HostServiceHyprDispatchRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type HostServiceHyprDispatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HostServiceHyprDispatchResponse) Reset() {
+	*x = HostServiceHyprDispatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostServiceHyprDispatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostServiceHyprDispatchResponse) ProtoMessage() {}
+
+func (x *HostServiceHyprDispatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostServiceHyprDispatchResponse.ProtoReflect.Descriptor instead.
+func (*HostServiceHyprDispatchResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{57}
+}
+
+type WidgetServiceInitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PanelId string            `protobuf:"bytes,1,opt,name=panel_id,json=panelId,proto3" json:"panel_id,omitempty"`
+	Name    string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Config  map[string]string `protobuf:"bytes,3,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WidgetServiceInitRequest) Reset() {
+	*x = WidgetServiceInitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceInitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceInitRequest) ProtoMessage() {}
+
+func (x *WidgetServiceInitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceInitRequest.ProtoReflect.Descriptor instead.
+func (*WidgetServiceInitRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WidgetServiceInitRequest) GetPanelId() string {
+	if x != nil {
+		return x.PanelId
+	}
+	return ""
+}
+
+func (x *WidgetServiceInitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WidgetServiceInitRequest) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type WidgetServiceInitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WidgetServiceInitResponse) Reset() {
+	*x = WidgetServiceInitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceInitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceInitResponse) ProtoMessage() {}
+
+func (x *WidgetServiceInitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceInitResponse.ProtoReflect.Descriptor instead.
+func (*WidgetServiceInitResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{59}
+}
+
+type WidgetServiceWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PanelId string `protobuf:"bytes,1,opt,name=panel_id,json=panelId,proto3" json:"panel_id,omitempty"`
+}
+
+func (x *WidgetServiceWatchRequest) Reset() {
+	*x = WidgetServiceWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceWatchRequest) ProtoMessage() {}
+
+func (x *WidgetServiceWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceWatchRequest.ProtoReflect.Descriptor instead.
+func (*WidgetServiceWatchRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WidgetServiceWatchRequest) GetPanelId() string {
+	if x != nil {
+		return x.PanelId
+	}
+	return ""
+}
+
+type WidgetServiceWatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root *v12.Node `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *WidgetServiceWatchResponse) Reset() {
+	*x = WidgetServiceWatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceWatchResponse) ProtoMessage() {}
+
+func (x *WidgetServiceWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceWatchResponse.ProtoReflect.Descriptor instead.
+func (*WidgetServiceWatchResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WidgetServiceWatchResponse) GetRoot() *v12.Node {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+type WidgetServiceClickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PanelId string     `protobuf:"bytes,1,opt,name=panel_id,json=panelId,proto3" json:"panel_id,omitempty"`
+	NodeId  string     `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Button  v12.Button `protobuf:"varint,3,opt,name=button,proto3,enum=hyprpanel.widget.v1.Button" json:"button,omitempty"`
+}
+
+func (x *WidgetServiceClickRequest) Reset() {
+	*x = WidgetServiceClickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceClickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceClickRequest) ProtoMessage() {}
+
+func (x *WidgetServiceClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceClickRequest.ProtoReflect.Descriptor instead.
+func (*WidgetServiceClickRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WidgetServiceClickRequest) GetPanelId() string {
+	if x != nil {
+		return x.PanelId
+	}
+	return ""
+}
+
+func (x *WidgetServiceClickRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *WidgetServiceClickRequest) GetButton() v12.Button {
+	if x != nil {
+		return x.Button
+	}
+	return v12.Button(0)
+}
+
+type WidgetServiceClickResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WidgetServiceClickResponse) Reset() {
+	*x = WidgetServiceClickResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceClickResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceClickResponse) ProtoMessage() {}
+
+func (x *WidgetServiceClickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceClickResponse.ProtoReflect.Descriptor instead.
+func (*WidgetServiceClickResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{63}
+}
+
+type WidgetServiceScrollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PanelId   string       `protobuf:"bytes,1,opt,name=panel_id,json=panelId,proto3" json:"panel_id,omitempty"`
+	NodeId    string       `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Direction v1.Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=hyprpanel.event.v1.Direction" json:"direction,omitempty"`
+}
+
+func (x *WidgetServiceScrollRequest) Reset() {
+	*x = WidgetServiceScrollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceScrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceScrollRequest) ProtoMessage() {}
+
+func (x *WidgetServiceScrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceScrollRequest.ProtoReflect.Descriptor instead.
+func (*WidgetServiceScrollRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WidgetServiceScrollRequest) GetPanelId() string {
+	if x != nil {
+		return x.PanelId
+	}
+	return ""
+}
+
+func (x *WidgetServiceScrollRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *WidgetServiceScrollRequest) GetDirection() v1.Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return v1.Direction(0)
+}
+
+type WidgetServiceScrollResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WidgetServiceScrollResponse) Reset() {
+	*x = WidgetServiceScrollResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceScrollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceScrollResponse) ProtoMessage() {}
+
+func (x *WidgetServiceScrollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceScrollResponse.ProtoReflect.Descriptor instead.
+func (*WidgetServiceScrollResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{65}
+}
+
+type WidgetServiceMenuActivateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PanelId string `protobuf:"bytes,1,opt,name=panel_id,json=panelId,proto3" json:"panel_id,omitempty"`
+	NodeId  string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ItemId  string `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (x *WidgetServiceMenuActivateRequest) Reset() {
+	*x = WidgetServiceMenuActivateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceMenuActivateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceMenuActivateRequest) ProtoMessage() {}
+
+func (x *WidgetServiceMenuActivateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceMenuActivateRequest.ProtoReflect.Descriptor instead.
+func (*WidgetServiceMenuActivateRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *WidgetServiceMenuActivateRequest) GetPanelId() string {
+	if x != nil {
+		return x.PanelId
+	}
+	return ""
+}
+
+func (x *WidgetServiceMenuActivateRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *WidgetServiceMenuActivateRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type WidgetServiceMenuActivateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WidgetServiceMenuActivateResponse) Reset() {
+	*x = WidgetServiceMenuActivateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WidgetServiceMenuActivateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidgetServiceMenuActivateResponse) ProtoMessage() {}
+
+func (x *WidgetServiceMenuActivateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidgetServiceMenuActivateResponse.ProtoReflect.Descriptor instead.
+func (*WidgetServiceMenuActivateResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{67}
+}
+
+type ControlServiceReloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ControlServiceReloadRequest) Reset() {
+	*x = ControlServiceReloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlServiceReloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceReloadRequest) ProtoMessage() {}
+
+func (x *ControlServiceReloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceReloadRequest.ProtoReflect.Descriptor instead.
+func (*ControlServiceReloadRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{68}
+}
+
+type ControlServiceReloadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ControlServiceReloadResponse) Reset() {
+	*x = ControlServiceReloadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlServiceReloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceReloadResponse) ProtoMessage() {}
+
+func (x *ControlServiceReloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceReloadResponse.ProtoReflect.Descriptor instead.
+func (*ControlServiceReloadResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{69}
+}
+
+type ControlServiceQuitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ControlServiceQuitRequest) Reset() {
+	*x = ControlServiceQuitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlServiceQuitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceQuitRequest) ProtoMessage() {}
+
+func (x *ControlServiceQuitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceQuitRequest.ProtoReflect.Descriptor instead.
+func (*ControlServiceQuitRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{70}
+}
+
+type ControlServiceQuitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ControlServiceQuitResponse) Reset() {
+	*x = ControlServiceQuitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlServiceQuitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceQuitResponse) ProtoMessage() {}
+
+func (x *ControlServiceQuitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceQuitResponse.ProtoReflect.Descriptor instead.
+func (*ControlServiceQuitResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{71}
+}
+
+type ControlServiceEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ControlServiceEventsRequest) Reset() {
+	*x = ControlServiceEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlServiceEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceEventsRequest) ProtoMessage() {}
+
+func (x *ControlServiceEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceEventsRequest.ProtoReflect.Descriptor instead.
+func (*ControlServiceEventsRequest) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{72}
+}
+
+type ControlServiceEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *v1.RecordedEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *ControlServiceEventsResponse) Reset() {
+	*x = ControlServiceEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlServiceEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlServiceEventsResponse) ProtoMessage() {}
+
+func (x *ControlServiceEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlServiceEventsResponse.ProtoReflect.Descriptor instead.
+func (*ControlServiceEventsResponse) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ControlServiceEventsResponse) GetEvent() *v1.RecordedEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type AppInfo_Action struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Icon    string   `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
+	Exec    []string `protobuf:"bytes,3,rep,name=exec,proto3" json:"exec,omitempty"`
+	RawExec string   `protobuf:"bytes,4,opt,name=raw_exec,json=rawExec,proto3" json:"raw_exec,omitempty"`
+}
+
+func (x *AppInfo_Action) Reset() {
+	*x = AppInfo_Action{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppInfo_Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppInfo_Action) ProtoMessage() {}
+
+func (x *AppInfo_Action) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_v1_hyprpanel_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppInfo_Action.ProtoReflect.Descriptor instead.
+func (*AppInfo_Action) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *AppInfo_Action) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppInfo_Action) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *AppInfo_Action) GetExec() []string {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *AppInfo_Action) GetRawExec() string {
+	if x != nil {
+		return x.RawExec
+	}
+	return ""
+}
+
+var File_hyprpanel_v1_hyprpanel_proto protoreflect.FileDescriptor
+
+var file_hyprpanel_v1_hyprpanel_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77,
+	0x69, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6a, 0x0a, 0x0a, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x4e, 0x52, 0x47, 0x42, 0x41, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x78,
+	0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x69, 0x78, 0x65, 0x6c,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x91, 0x03, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x6b, 0x74,
+	0x6f, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x72, 0x79, 0x45, 0x78, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x65,
+	0x63, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x61, 0x77, 0x45, 0x78, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x5f, 0x77, 0x6d, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x57,
+	0x6d, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5f, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x77, 0x45, 0x78, 0x65, 0x63, 0x22, 0xa2, 0x01, 0x0a, 0x0c,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
+	0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x22, 0x8d, 0x02, 0x0a, 0x17, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74,
+	0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x22, 0x98, 0x01, 0x0a, 0x18, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x0b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x05, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22,
+	0x4c, 0x0a, 0x19, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1c, 0x0a,
+	0x1a, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x1f, 0x50,
+	0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x22, 0x0a, 0x20, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x1e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x73, 0x68, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x50, 0x61, 0x6e, 0x65, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x24, 0x50, 0x61,
+	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x27, 0x0a, 0x25, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x50,
+	0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x50, 0x61, 0x6e, 0x65, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x39, 0x0a, 0x21, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x56, 0x0a, 0x22, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x70, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x5a, 0x0a, 0x21, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78,
+	0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x24,
+	0x0a, 0x22, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x2a, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a,
+	0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x2d, 0x0a, 0x2b, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x1f, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53,
+	0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x48, 0x0a,
+	0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x4f,
+	0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72,
+	0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x2c, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61,
+	0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
+	0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x79, 0x22, 0x2f, 0x0a, 0x2d, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x28, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f,
+	0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65,
+	0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x29,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72,
+	0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x22, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
+	0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x08, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x73,
+	0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x25,
+	0x0a, 0x23, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x24, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x27, 0x0a,
+	0x25, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x24, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x27, 0x0a,
+	0x25, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x27, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a,
+	0x0a, 0x28, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x25, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69,
+	0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x26, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a,
+	0x29, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x2a, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x27, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d,
+	0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x2a, 0x0a, 0x28, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x22,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x23, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x68, 0x0a, 0x1e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5c, 0x0a, 0x1f, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e,
+	0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x22, 0x0a, 0x20, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69,
+	0x62, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a,
+	0x1f, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x4e, 0x52, 0x47, 0x42, 0x41, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x22, 0x1f, 0x0a, 0x1d, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x40, 0x0a, 0x21, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x65, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x5f, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x55, 0x73, 0x22, 0x65, 0x0a, 0x27, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x22, 0x20, 0x0a, 0x1e, 0x48, 0x6f,
 	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x55,
-	0x73, 0x22, 0x20, 0x0a, 0x1e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2a, 0x9a, 0x01, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53,
-	0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2a, 0x0a, 0x26, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x53, 0x43, 0x52, 0x4f,
-	0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23,
-	0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x53, 0x43, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x4f,
-	0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x45, 0x52, 0x54, 0x49,
-	0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x0a, 0x1b,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x79, 0x70, 0x72, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x79, 0x70, 0x72, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x32, 0x0a, 0x1c, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x79, 0x70, 0x72, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x34,
+	0x0a, 0x1e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x79, 0x70,
+	0x72, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x48, 0x79, 0x70, 0x72, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x18, 0x57, 0x69, 0x64, 0x67,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
+	0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1b, 0x0a, 0x19, 0x57, 0x69,
+	0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x19, 0x57, 0x69, 0x64, 0x67, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22,
+	0x4b, 0x0a, 0x1a, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x84, 0x01, 0x0a,
+	0x19, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c,
+	0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x33,
+	0x0a, 0x06, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69, 0x64, 0x67, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x52, 0x06, 0x62, 0x75, 0x74,
+	0x74, 0x6f, 0x6e, 0x22, 0x1c, 0x0a, 0x1a, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1a, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x1d, 0x0a, 0x1b, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x6f, 0x0a, 0x20, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x22, 0x23, 0x0a, 0x21, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x51, 0x75, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x51, 0x75, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x57, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x9a, 0x01, 0x0a, 0x18, 0x53, 0x79, 0x73,
+	0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x26, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59,
 	0x5f, 0x53, 0x43, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x4f, 0x4e, 0x54, 0x41, 0x4c, 0x10, 0x02,
-	0x2a, 0x76, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f,
-	0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x59, 0x53, 0x54,
-	0x52, 0x41, 0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43,
-	0x4c, 0x49, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x59, 0x53, 0x54,
-	0x52, 0x41, 0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48,
-	0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xbf, 0x01, 0x0a, 0x18, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x26, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41,
-	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
-	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x4e, 0x4f, 0x54,
-	0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
-	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x4d, 0x49, 0x53, 0x53, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
-	0x4e, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x32, 0x9c, 0x02, 0x0a, 0x0c, 0x50,
-	0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x04, 0x49,
-	0x6e, 0x69, 0x74, 0x12, 0x25, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x79, 0x70,
-	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x27, 0x2e, 0x68,
-	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x58, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x27, 0x0a, 0x23, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x53, 0x43, 0x52,
+	0x4f, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x56, 0x45, 0x52, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x53, 0x59,
+	0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x53, 0x43, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x49,
+	0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x4f, 0x4e,
+	0x54, 0x41, 0x4c, 0x10, 0x02, 0x2a, 0x76, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
+	0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x59, 0x53,
+	0x54, 0x52, 0x41, 0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a,
+	0x1a, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a,
+	0x1a, 0x53, 0x59, 0x53, 0x54, 0x52, 0x41, 0x59, 0x5f, 0x4d, 0x45, 0x4e, 0x55, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x48, 0x4f, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xbf, 0x01,
+	0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x26, 0x4e, 0x4f,
+	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x28,
+	0x0a, 0x24, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53,
+	0x4d, 0x49, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x2a,
+	0xb2, 0x01, 0x0a, 0x09, 0x48, 0x79, 0x70, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a,
+	0x16, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x48, 0x59, 0x50,
+	0x52, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x53, 0x10,
+	0x01, 0x12, 0x17, 0x0a, 0x13, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f,
+	0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x53, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x59,
+	0x50, 0x52, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x53, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x51, 0x55,
+	0x45, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x48, 0x59, 0x50, 0x52, 0x5f, 0x51, 0x55, 0x45, 0x52,
+	0x59, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x10, 0x05, 0x32, 0xfb, 0x03, 0x0a, 0x0c, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e,
+	0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x06,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x27, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0c, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
 	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf4, 0x18, 0x0a, 0x0b, 0x48, 0x6f,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x04, 0x45, 0x78, 0x65,
-	0x63, 0x12, 0x24, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74,
-	0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x64,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e,
-	0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x18, 0x53,
-	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x38, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61,
-	0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x39, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x12, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xc5, 0x1a, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x53, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x24, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x65, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a,
+	0x0f, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x12, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72,
+	0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
 	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74,
-	0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d,
-	0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x2d, 0x2e,
+	0x72, 0x61, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x18, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x12, 0x38, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72,
+	0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x61, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
+	0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x1a, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61,
+	0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72,
+	0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x89, 0x01,
+	0x0a, 0x16, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f,
+	0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x36, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62,
+	0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x37, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72,
+	0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x53, 0x79, 0x73,
+	0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e,
 	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53,
-	0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68,
-	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x53, 0x63,
-	0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x95, 0x01, 0x0a,
-	0x1a, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x2e, 0x68, 0x79,
-	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e,
-	0x75, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79,
-	0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x12,
-	0x36, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d,
+	0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x31, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48,
 	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61,
-	0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7d, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x32, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7d, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
 	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x62, 0x6f,
-	0x75, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x77, 0x0a, 0x10, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x53, 0x79, 0x73, 0x74, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x12, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12,
-	0x32, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32,
-	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73,
-	0x74, 0x12, 0x35, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x86, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12, 0x35, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x36, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
 	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69,
 	0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x80, 0x01, 0x0a, 0x13, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75,
-	0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x33, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
-	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65,
-	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
-	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e,
-	0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12,
-	0x37, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x12, 0x33, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x69, 0x6e, 0x6b, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8c, 0x01, 0x0a,
+	0x17, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12, 0x37, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
 	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x35, 0x2e, 0x68,
+	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x38, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x15,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x35, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68,
 	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x42,
-	0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12,
-	0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74,
-	0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x31, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72, 0x69, 0x67,
-	0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46,
-	0x72, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
-	0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70,
-	0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x75, 0x0a, 0x14, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74,
-	0x6f, 0x72, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x12, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x63, 0x65, 0x4d, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
 	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x49, 0x64, 0x6c, 0x65,
-	0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x55, 0x6e, 0x69, 0x6e, 0x68, 0x69, 0x62,
+	0x69, 0x63, 0x65, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x41,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x0c, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x14, 0x49, 0x64,
+	0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x68, 0x69, 0x62,
 	0x69, 0x74, 0x12, 0x2d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
 	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x6c,
 	0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
 	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x6c, 0x65,
 	0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x71, 0x0a, 0x14, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x50, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72,
-	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
-	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d,
-	0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6d, 0x0a, 0x10, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65,
-	0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x53, 0x74, 0x6f, 0x70, 0x12, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d,
-	0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69,
-	0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x6c, 0x0a, 0x0f, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x65,
-	0x78, 0x74, 0x12, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
+	0x65, 0x12, 0x77, 0x0a, 0x16, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74,
+	0x6f, 0x72, 0x55, 0x6e, 0x69, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74, 0x12, 0x2d, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x68, 0x69, 0x62, 0x69, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x12, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
 	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64,
 	0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48,
 	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a,
-	0x13, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x65, 0x76,
-	0x69, 0x6f, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a,
+	0x0f, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79,
+	0x12, 0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x10, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12,
+	0x2b, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x2b, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0f, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x2b, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x2b, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70,
+	0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x65, 0x6b, 0x12, 0x2f, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7d, 0x0a, 0x16, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
 	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d,
-	0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69,
-	0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x70, 0x0a, 0x0f, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65,
-	0x65, 0x6b, 0x12, 0x2f, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65,
-	0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x7d, 0x0a, 0x16, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x68, 0x79,
+	0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79,
 	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x53, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0xac, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x64, 0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x48, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
-	0x65, 0x6c, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
-	0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0d, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x09, 0x48, 0x79, 0x70,
+	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x48, 0x79, 0x70, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x79, 0x70, 0x72,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x0c, 0x48, 0x79, 0x70, 0x72, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x79, 0x70, 0x72, 0x44, 0x69, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x79, 0x70, 0x72, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf2, 0x03, 0x0a, 0x0d, 0x57,
+	0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x04,
+	0x49, 0x6e, 0x69, 0x74, 0x12, 0x26, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x64, 0x67,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x27,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69,
+	0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x05, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x64, 0x67,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x06, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f,
+	0x0a, 0x0c, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x2e,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69,
+	0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69,
+	0x64, 0x67, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xaf, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x5f, 0x0a, 0x06, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x29, 0x2e, 0x68,
+	0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x04, 0x51, 0x75, 0x69, 0x74, 0x12, 0x27, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x51, 0x75, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x51, 0x75, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
+	0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70,
+	0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x42, 0xac, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x64, 0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x48, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61,
+	0x6e, 0x65, 0x6c, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e,
+	0x65, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0d, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3122,147 +4622,215 @@ func file_hyprpanel_v1_hyprpanel_proto_rawDescGZIP() []byte {
 	return file_hyprpanel_v1_hyprpanel_proto_rawDescData
 }
 
-var file_hyprpanel_v1_hyprpanel_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_hyprpanel_v1_hyprpanel_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_hyprpanel_v1_hyprpanel_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_hyprpanel_v1_hyprpanel_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_hyprpanel_v1_hyprpanel_proto_goTypes = []interface{}{
 	(SystrayScrollOrientation)(0),                         // 0: hyprpanel.v1.SystrayScrollOrientation
 	(SystrayMenuEvent)(0),                                 // 1: hyprpanel.v1.SystrayMenuEvent
 	(NotificationClosedReason)(0),                         // 2: hyprpanel.v1.NotificationClosedReason
-	(*ImageNRGBA)(nil),                                    // 3: hyprpanel.v1.ImageNRGBA
-	(*AppInfo)(nil),                                       // 4: hyprpanel.v1.AppInfo
-	(*PanelServiceInitRequest)(nil),                       // 5: hyprpanel.v1.PanelServiceInitRequest
-	(*PanelServiceInitResponse)(nil),                      // 6: hyprpanel.v1.PanelServiceInitResponse
-	(*PanelServiceNotifyRequest)(nil),                     // 7: hyprpanel.v1.PanelServiceNotifyRequest
-	(*PanelServiceNotifyResponse)(nil),                    // 8: hyprpanel.v1.PanelServiceNotifyResponse
-	(*PanelServiceNotificationCloseRequest)(nil),          // 9: hyprpanel.v1.PanelServiceNotificationCloseRequest
-	(*PanelServiceNotificationCloseResponse)(nil),         // 10: hyprpanel.v1.PanelServiceNotificationCloseResponse
-	(*PanelServiceCloseRequest)(nil),                      // 11: hyprpanel.v1.PanelServiceCloseRequest
-	(*PanelServiceCloseResponse)(nil),                     // 12: hyprpanel.v1.PanelServiceCloseResponse
-	(*HostServiceExecRequest)(nil),                        // 13: hyprpanel.v1.HostServiceExecRequest
-	(*HostServiceExecResponse)(nil),                       // 14: hyprpanel.v1.HostServiceExecResponse
-	(*HostServiceFindApplicationRequest)(nil),             // 15: hyprpanel.v1.HostServiceFindApplicationRequest
-	(*HostServiceFindApplicationResponse)(nil),            // 16: hyprpanel.v1.HostServiceFindApplicationResponse
-	(*HostServiceSystrayActivateRequest)(nil),             // 17: hyprpanel.v1.HostServiceSystrayActivateRequest
-	(*HostServiceSystrayActivateResponse)(nil),            // 18: hyprpanel.v1.HostServiceSystrayActivateResponse
-	(*HostServiceSystraySecondaryActivateRequest)(nil),    // 19: hyprpanel.v1.HostServiceSystraySecondaryActivateRequest
-	(*HostServiceSystraySecondaryActivateResponse)(nil),   // 20: hyprpanel.v1.HostServiceSystraySecondaryActivateResponse
-	(*HostServiceSystrayScrollRequest)(nil),               // 21: hyprpanel.v1.HostServiceSystrayScrollRequest
-	(*HostServiceSystrayScrollResponse)(nil),              // 22: hyprpanel.v1.HostServiceSystrayScrollResponse
-	(*HostServiceSystrayMenuContextActivateRequest)(nil),  // 23: hyprpanel.v1.HostServiceSystrayMenuContextActivateRequest
-	(*HostServiceSystrayMenuContextActivateResponse)(nil), // 24: hyprpanel.v1.HostServiceSystrayMenuContextActivateResponse
-	(*HostServiceSystrayMenuAboutToShowRequest)(nil),      // 25: hyprpanel.v1.HostServiceSystrayMenuAboutToShowRequest
-	(*HostServiceSystrayMenuAboutToShowResponse)(nil),     // 26: hyprpanel.v1.HostServiceSystrayMenuAboutToShowResponse
-	(*HostServiceSystrayMenuEventRequest)(nil),            // 27: hyprpanel.v1.HostServiceSystrayMenuEventRequest
-	(*HostServiceSystrayMenuEventResponse)(nil),           // 28: hyprpanel.v1.HostServiceSystrayMenuEventResponse
-	(*HostServiceNotificationClosedRequest)(nil),          // 29: hyprpanel.v1.HostServiceNotificationClosedRequest
-	(*HostServiceNotificationClosedResponse)(nil),         // 30: hyprpanel.v1.HostServiceNotificationClosedResponse
-	(*HostServiceNotificationActionRequest)(nil),          // 31: hyprpanel.v1.HostServiceNotificationActionRequest
-	(*HostServiceNotificationActionResponse)(nil),         // 32: hyprpanel.v1.HostServiceNotificationActionResponse
-	(*HostServiceAudioSinkVolumeAdjustRequest)(nil),       // 33: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest
-	(*HostServiceAudioSinkVolumeAdjustResponse)(nil),      // 34: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustResponse
-	(*HostServiceAudioSinkMuteToggleRequest)(nil),         // 35: hyprpanel.v1.HostServiceAudioSinkMuteToggleRequest
-	(*HostServiceAudioSinkMuteToggleResponse)(nil),        // 36: hyprpanel.v1.HostServiceAudioSinkMuteToggleResponse
-	(*HostServiceAudioSourceVolumeAdjustRequest)(nil),     // 37: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest
-	(*HostServiceAudioSourceVolumeAdjustResponse)(nil),    // 38: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustResponse
-	(*HostServiceAudioSourceMuteToggleRequest)(nil),       // 39: hyprpanel.v1.HostServiceAudioSourceMuteToggleRequest
-	(*HostServiceAudioSourceMuteToggleResponse)(nil),      // 40: hyprpanel.v1.HostServiceAudioSourceMuteToggleResponse
-	(*HostServiceBrightnessAdjustRequest)(nil),            // 41: hyprpanel.v1.HostServiceBrightnessAdjustRequest
-	(*HostServiceBrightnessAdjustResponse)(nil),           // 42: hyprpanel.v1.HostServiceBrightnessAdjustResponse
-	(*HostServiceCaptureFrameRequest)(nil),                // 43: hyprpanel.v1.HostServiceCaptureFrameRequest
-	(*HostServiceIdleInhibitorRequest)(nil),               // 44: hyprpanel.v1.HostServiceIdleInhibitorRequest
-	(*HostServiceIdleInhibitorResponse)(nil),              // 45: hyprpanel.v1.HostServiceIdleInhibitorResponse
-	(*HostServiceCaptureFrameResponse)(nil),               // 46: hyprpanel.v1.HostServiceCaptureFrameResponse
-	(*HostServiceMediaPlayerRequest)(nil),                 // 47: hyprpanel.v1.HostServiceMediaPlayerRequest
-	(*HostServiceMediaPlayerSeekRequest)(nil),             // 48: hyprpanel.v1.HostServiceMediaPlayerSeekRequest
-	(*HostServiceMediaPlayerSetPostionRequest)(nil),       // 49: hyprpanel.v1.HostServiceMediaPlayerSetPostionRequest
-	(*HostServiceMediaPlayerResponse)(nil),                // 50: hyprpanel.v1.HostServiceMediaPlayerResponse
-	(*AppInfo_Action)(nil),                                // 51: hyprpanel.v1.AppInfo.Action
-	(v1.LogLevel)(0),                                      // 52: hyprpanel.config.v1.LogLevel
-	(*v1.Panel)(nil),                                      // 53: hyprpanel.config.v1.Panel
-	(*v11.Event)(nil),                                     // 54: hyprpanel.event.v1.Event
-	(*anypb.Any)(nil),                                     // 55: google.protobuf.Any
-	(v11.Direction)(0),                                    // 56: hyprpanel.event.v1.Direction
-	(v11.InhibitTarget)(0),                                // 57: hyprpanel.event.v1.InhibitTarget
+	(HyprQuery)(0),                                        // 3: hyprpanel.v1.HyprQuery
+	(*ImageNRGBA)(nil),                                    // 4: hyprpanel.v1.ImageNRGBA
+	(*AppInfo)(nil),                                       // 5: hyprpanel.v1.AppInfo
+	(*Capabilities)(nil),                                  // 6: hyprpanel.v1.Capabilities
+	(*PanelServiceInitRequest)(nil),                       // 7: hyprpanel.v1.PanelServiceInitRequest
+	(*PanelServiceInitResponse)(nil),                      // 8: hyprpanel.v1.PanelServiceInitResponse
+	(*EventFilter)(nil),                                   // 9: hyprpanel.v1.EventFilter
+	(*PanelServiceNotifyRequest)(nil),                     // 10: hyprpanel.v1.PanelServiceNotifyRequest
+	(*PanelServiceNotifyResponse)(nil),                    // 11: hyprpanel.v1.PanelServiceNotifyResponse
+	(*PanelServiceNotifyStreamRequest)(nil),               // 12: hyprpanel.v1.PanelServiceNotifyStreamRequest
+	(*PanelServiceNotifyStreamResponse)(nil),              // 13: hyprpanel.v1.PanelServiceNotifyStreamResponse
+	(*PanelServiceUpdateStyleRequest)(nil),                // 14: hyprpanel.v1.PanelServiceUpdateStyleRequest
+	(*PanelServiceUpdateStyleResponse)(nil),               // 15: hyprpanel.v1.PanelServiceUpdateStyleResponse
+	(*PanelServiceNotificationCloseRequest)(nil),          // 16: hyprpanel.v1.PanelServiceNotificationCloseRequest
+	(*PanelServiceNotificationCloseResponse)(nil),         // 17: hyprpanel.v1.PanelServiceNotificationCloseResponse
+	(*PanelServiceCloseRequest)(nil),                      // 18: hyprpanel.v1.PanelServiceCloseRequest
+	(*PanelServiceCloseResponse)(nil),                     // 19: hyprpanel.v1.PanelServiceCloseResponse
+	(*HostServiceExecRequest)(nil),                        // 20: hyprpanel.v1.HostServiceExecRequest
+	(*HostServiceExecResponse)(nil),                       // 21: hyprpanel.v1.HostServiceExecResponse
+	(*HostServiceFindApplicationRequest)(nil),             // 22: hyprpanel.v1.HostServiceFindApplicationRequest
+	(*HostServiceFindApplicationResponse)(nil),            // 23: hyprpanel.v1.HostServiceFindApplicationResponse
+	(*HostServiceSystrayActivateRequest)(nil),             // 24: hyprpanel.v1.HostServiceSystrayActivateRequest
+	(*HostServiceSystrayActivateResponse)(nil),            // 25: hyprpanel.v1.HostServiceSystrayActivateResponse
+	(*HostServiceSystraySecondaryActivateRequest)(nil),    // 26: hyprpanel.v1.HostServiceSystraySecondaryActivateRequest
+	(*HostServiceSystraySecondaryActivateResponse)(nil),   // 27: hyprpanel.v1.HostServiceSystraySecondaryActivateResponse
+	(*HostServiceSystrayScrollRequest)(nil),               // 28: hyprpanel.v1.HostServiceSystrayScrollRequest
+	(*HostServiceSystrayScrollResponse)(nil),              // 29: hyprpanel.v1.HostServiceSystrayScrollResponse
+	(*HostServiceSystrayMenuContextActivateRequest)(nil),  // 30: hyprpanel.v1.HostServiceSystrayMenuContextActivateRequest
+	(*HostServiceSystrayMenuContextActivateResponse)(nil), // 31: hyprpanel.v1.HostServiceSystrayMenuContextActivateResponse
+	(*HostServiceSystrayMenuAboutToShowRequest)(nil),      // 32: hyprpanel.v1.HostServiceSystrayMenuAboutToShowRequest
+	(*HostServiceSystrayMenuAboutToShowResponse)(nil),     // 33: hyprpanel.v1.HostServiceSystrayMenuAboutToShowResponse
+	(*HostServiceSystrayMenuEventRequest)(nil),            // 34: hyprpanel.v1.HostServiceSystrayMenuEventRequest
+	(*HostServiceSystrayMenuEventResponse)(nil),           // 35: hyprpanel.v1.HostServiceSystrayMenuEventResponse
+	(*HostServiceNotificationClosedRequest)(nil),          // 36: hyprpanel.v1.HostServiceNotificationClosedRequest
+	(*HostServiceNotificationClosedResponse)(nil),         // 37: hyprpanel.v1.HostServiceNotificationClosedResponse
+	(*HostServiceNotificationActionRequest)(nil),          // 38: hyprpanel.v1.HostServiceNotificationActionRequest
+	(*HostServiceNotificationActionResponse)(nil),         // 39: hyprpanel.v1.HostServiceNotificationActionResponse
+	(*HostServiceAudioSinkVolumeAdjustRequest)(nil),       // 40: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest
+	(*HostServiceAudioSinkVolumeAdjustResponse)(nil),      // 41: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustResponse
+	(*HostServiceAudioSinkMuteToggleRequest)(nil),         // 42: hyprpanel.v1.HostServiceAudioSinkMuteToggleRequest
+	(*HostServiceAudioSinkMuteToggleResponse)(nil),        // 43: hyprpanel.v1.HostServiceAudioSinkMuteToggleResponse
+	(*HostServiceAudioSourceVolumeAdjustRequest)(nil),     // 44: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest
+	(*HostServiceAudioSourceVolumeAdjustResponse)(nil),    // 45: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustResponse
+	(*HostServiceAudioSourceMuteToggleRequest)(nil),       // 46: hyprpanel.v1.HostServiceAudioSourceMuteToggleRequest
+	(*HostServiceAudioSourceMuteToggleResponse)(nil),      // 47: hyprpanel.v1.HostServiceAudioSourceMuteToggleResponse
+	(*HostServiceBrightnessAdjustRequest)(nil),            // 48: hyprpanel.v1.HostServiceBrightnessAdjustRequest
+	(*HostServiceBrightnessAdjustResponse)(nil),           // 49: hyprpanel.v1.HostServiceBrightnessAdjustResponse
+	(*HostServiceCaptureFrameRequest)(nil),                // 50: hyprpanel.v1.HostServiceCaptureFrameRequest
+	(*HostServiceIdleInhibitorRequest)(nil),               // 51: hyprpanel.v1.HostServiceIdleInhibitorRequest
+	(*HostServiceIdleInhibitorResponse)(nil),              // 52: hyprpanel.v1.HostServiceIdleInhibitorResponse
+	(*HostServiceCaptureFrameResponse)(nil),               // 53: hyprpanel.v1.HostServiceCaptureFrameResponse
+	(*HostServiceMediaPlayerRequest)(nil),                 // 54: hyprpanel.v1.HostServiceMediaPlayerRequest
+	(*HostServiceMediaPlayerSeekRequest)(nil),             // 55: hyprpanel.v1.HostServiceMediaPlayerSeekRequest
+	(*HostServiceMediaPlayerSetPostionRequest)(nil),       // 56: hyprpanel.v1.HostServiceMediaPlayerSetPostionRequest
+	(*HostServiceMediaPlayerResponse)(nil),                // 57: hyprpanel.v1.HostServiceMediaPlayerResponse
+	(*HostServiceHyprQueryRequest)(nil),                   // 58: hyprpanel.v1.HostServiceHyprQueryRequest
+	(*HostServiceHyprQueryResponse)(nil),                  // 59: hyprpanel.v1.HostServiceHyprQueryResponse
+	(*HostServiceHyprDispatchRequest)(nil),                // 60: hyprpanel.v1.HostServiceHyprDispatchRequest
+	(*HostServiceHyprDispatchResponse)(nil),               // 61: hyprpanel.v1.HostServiceHyprDispatchResponse
+	(*WidgetServiceInitRequest)(nil),                      // 62: hyprpanel.v1.WidgetServiceInitRequest
+	(*WidgetServiceInitResponse)(nil),                     // 63: hyprpanel.v1.WidgetServiceInitResponse
+	(*WidgetServiceWatchRequest)(nil),                     // 64: hyprpanel.v1.WidgetServiceWatchRequest
+	(*WidgetServiceWatchResponse)(nil),                    // 65: hyprpanel.v1.WidgetServiceWatchResponse
+	(*WidgetServiceClickRequest)(nil),                     // 66: hyprpanel.v1.WidgetServiceClickRequest
+	(*WidgetServiceClickResponse)(nil),                    // 67: hyprpanel.v1.WidgetServiceClickResponse
+	(*WidgetServiceScrollRequest)(nil),                    // 68: hyprpanel.v1.WidgetServiceScrollRequest
+	(*WidgetServiceScrollResponse)(nil),                   // 69: hyprpanel.v1.WidgetServiceScrollResponse
+	(*WidgetServiceMenuActivateRequest)(nil),              // 70: hyprpanel.v1.WidgetServiceMenuActivateRequest
+	(*WidgetServiceMenuActivateResponse)(nil),             // 71: hyprpanel.v1.WidgetServiceMenuActivateResponse
+	(*ControlServiceReloadRequest)(nil),                   // 72: hyprpanel.v1.ControlServiceReloadRequest
+	(*ControlServiceReloadResponse)(nil),                  // 73: hyprpanel.v1.ControlServiceReloadResponse
+	(*ControlServiceQuitRequest)(nil),                     // 74: hyprpanel.v1.ControlServiceQuitRequest
+	(*ControlServiceQuitResponse)(nil),                    // 75: hyprpanel.v1.ControlServiceQuitResponse
+	(*ControlServiceEventsRequest)(nil),                   // 76: hyprpanel.v1.ControlServiceEventsRequest
+	(*ControlServiceEventsResponse)(nil),                  // 77: hyprpanel.v1.ControlServiceEventsResponse
+	(*AppInfo_Action)(nil),                                // 78: hyprpanel.v1.AppInfo.Action
+	nil,                                                   // 79: hyprpanel.v1.WidgetServiceInitRequest.ConfigEntry
+	(v1.EventKind)(0),                                     // 80: hyprpanel.event.v1.EventKind
+	(v11.LogLevel)(0),                                     // 81: hyprpanel.config.v1.LogLevel
+	(*v11.Panel)(nil),                                     // 82: hyprpanel.config.v1.Panel
+	(*v1.Event)(nil),                                      // 83: hyprpanel.event.v1.Event
+	(*anypb.Any)(nil),                                     // 84: google.protobuf.Any
+	(v1.Direction)(0),                                     // 85: hyprpanel.event.v1.Direction
+	(v1.InhibitTarget)(0),                                 // 86: hyprpanel.event.v1.InhibitTarget
+	(*v12.Node)(nil),                                      // 87: hyprpanel.widget.v1.Node
+	(v12.Button)(0),                                       // 88: hyprpanel.widget.v1.Button
+	(*v1.RecordedEvent)(nil),                              // 89: hyprpanel.event.v1.RecordedEvent
 }
 var file_hyprpanel_v1_hyprpanel_proto_depIdxs = []int32{
-	51, // 0: hyprpanel.v1.AppInfo.actions:type_name -> hyprpanel.v1.AppInfo.Action
-	52, // 1: hyprpanel.v1.PanelServiceInitRequest.log_level:type_name -> hyprpanel.config.v1.LogLevel
-	53, // 2: hyprpanel.v1.PanelServiceInitRequest.config:type_name -> hyprpanel.config.v1.Panel
-	54, // 3: hyprpanel.v1.PanelServiceNotifyRequest.event:type_name -> hyprpanel.event.v1.Event
-	51, // 4: hyprpanel.v1.HostServiceExecRequest.action:type_name -> hyprpanel.v1.AppInfo.Action
-	4,  // 5: hyprpanel.v1.HostServiceFindApplicationResponse.app_info:type_name -> hyprpanel.v1.AppInfo
-	0,  // 6: hyprpanel.v1.HostServiceSystrayScrollRequest.orientation:type_name -> hyprpanel.v1.SystrayScrollOrientation
-	1,  // 7: hyprpanel.v1.HostServiceSystrayMenuEventRequest.event_id:type_name -> hyprpanel.v1.SystrayMenuEvent
-	55, // 8: hyprpanel.v1.HostServiceSystrayMenuEventRequest.data:type_name -> google.protobuf.Any
-	2,  // 9: hyprpanel.v1.HostServiceNotificationClosedRequest.reason:type_name -> hyprpanel.v1.NotificationClosedReason
-	56, // 10: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
-	56, // 11: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
-	56, // 12: hyprpanel.v1.HostServiceBrightnessAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
-	57, // 13: hyprpanel.v1.HostServiceIdleInhibitorRequest.target:type_name -> hyprpanel.event.v1.InhibitTarget
-	3,  // 14: hyprpanel.v1.HostServiceCaptureFrameResponse.image:type_name -> hyprpanel.v1.ImageNRGBA
-	5,  // 15: hyprpanel.v1.PanelService.Init:input_type -> hyprpanel.v1.PanelServiceInitRequest
-	7,  // 16: hyprpanel.v1.PanelService.Notify:input_type -> hyprpanel.v1.PanelServiceNotifyRequest
-	11, // 17: hyprpanel.v1.PanelService.Close:input_type -> hyprpanel.v1.PanelServiceCloseRequest
-	13, // 18: hyprpanel.v1.HostService.Exec:input_type -> hyprpanel.v1.HostServiceExecRequest
-	15, // 19: hyprpanel.v1.HostService.FindApplication:input_type -> hyprpanel.v1.HostServiceFindApplicationRequest
-	17, // 20: hyprpanel.v1.HostService.SystrayActivate:input_type -> hyprpanel.v1.HostServiceSystrayActivateRequest
-	19, // 21: hyprpanel.v1.HostService.SystraySecondaryActivate:input_type -> hyprpanel.v1.HostServiceSystraySecondaryActivateRequest
-	21, // 22: hyprpanel.v1.HostService.SystrayScroll:input_type -> hyprpanel.v1.HostServiceSystrayScrollRequest
-	23, // 23: hyprpanel.v1.HostService.SystrayMenuContextActivate:input_type -> hyprpanel.v1.HostServiceSystrayMenuContextActivateRequest
-	25, // 24: hyprpanel.v1.HostService.SystrayMenuAboutToShow:input_type -> hyprpanel.v1.HostServiceSystrayMenuAboutToShowRequest
-	27, // 25: hyprpanel.v1.HostService.SystrayMenuEvent:input_type -> hyprpanel.v1.HostServiceSystrayMenuEventRequest
-	29, // 26: hyprpanel.v1.HostService.NotificationClosed:input_type -> hyprpanel.v1.HostServiceNotificationClosedRequest
-	31, // 27: hyprpanel.v1.HostService.NotificationAction:input_type -> hyprpanel.v1.HostServiceNotificationActionRequest
-	33, // 28: hyprpanel.v1.HostService.AudioSinkVolumeAdjust:input_type -> hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest
-	35, // 29: hyprpanel.v1.HostService.AudioSinkMuteToggle:input_type -> hyprpanel.v1.HostServiceAudioSinkMuteToggleRequest
-	37, // 30: hyprpanel.v1.HostService.AudioSourceVolumeAdjust:input_type -> hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest
-	39, // 31: hyprpanel.v1.HostService.AudioSourceMuteToggle:input_type -> hyprpanel.v1.HostServiceAudioSourceMuteToggleRequest
-	41, // 32: hyprpanel.v1.HostService.BrightnessAdjust:input_type -> hyprpanel.v1.HostServiceBrightnessAdjustRequest
-	43, // 33: hyprpanel.v1.HostService.CaptureFrame:input_type -> hyprpanel.v1.HostServiceCaptureFrameRequest
-	44, // 34: hyprpanel.v1.HostService.IdleInhibitorInhibit:input_type -> hyprpanel.v1.HostServiceIdleInhibitorRequest
-	44, // 35: hyprpanel.v1.HostService.IdleInhibitorUninhibit:input_type -> hyprpanel.v1.HostServiceIdleInhibitorRequest
-	47, // 36: hyprpanel.v1.HostService.MediaPlayerPlayPause:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
-	47, // 37: hyprpanel.v1.HostService.MediaPlayerPlay:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
-	47, // 38: hyprpanel.v1.HostService.MediaPlayerPause:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
-	47, // 39: hyprpanel.v1.HostService.MediaPlayerStop:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
-	47, // 40: hyprpanel.v1.HostService.MediaPlayerNext:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
-	47, // 41: hyprpanel.v1.HostService.MediaPlayerPrevious:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
-	48, // 42: hyprpanel.v1.HostService.MediaPlayerSeek:input_type -> hyprpanel.v1.HostServiceMediaPlayerSeekRequest
-	49, // 43: hyprpanel.v1.HostService.MediaPlayerSetPosition:input_type -> hyprpanel.v1.HostServiceMediaPlayerSetPostionRequest
-	6,  // 44: hyprpanel.v1.PanelService.Init:output_type -> hyprpanel.v1.PanelServiceInitResponse
-	8,  // 45: hyprpanel.v1.PanelService.Notify:output_type -> hyprpanel.v1.PanelServiceNotifyResponse
-	12, // 46: hyprpanel.v1.PanelService.Close:output_type -> hyprpanel.v1.PanelServiceCloseResponse
-	14, // 47: hyprpanel.v1.HostService.Exec:output_type -> hyprpanel.v1.HostServiceExecResponse
-	16, // 48: hyprpanel.v1.HostService.FindApplication:output_type -> hyprpanel.v1.HostServiceFindApplicationResponse
-	18, // 49: hyprpanel.v1.HostService.SystrayActivate:output_type -> hyprpanel.v1.HostServiceSystrayActivateResponse
-	20, // 50: hyprpanel.v1.HostService.SystraySecondaryActivate:output_type -> hyprpanel.v1.HostServiceSystraySecondaryActivateResponse
-	22, // 51: hyprpanel.v1.HostService.SystrayScroll:output_type -> hyprpanel.v1.HostServiceSystrayScrollResponse
-	24, // 52: hyprpanel.v1.HostService.SystrayMenuContextActivate:output_type -> hyprpanel.v1.HostServiceSystrayMenuContextActivateResponse
-	26, // 53: hyprpanel.v1.HostService.SystrayMenuAboutToShow:output_type -> hyprpanel.v1.HostServiceSystrayMenuAboutToShowResponse
-	28, // 54: hyprpanel.v1.HostService.SystrayMenuEvent:output_type -> hyprpanel.v1.HostServiceSystrayMenuEventResponse
-	30, // 55: hyprpanel.v1.HostService.NotificationClosed:output_type -> hyprpanel.v1.HostServiceNotificationClosedResponse
-	32, // 56: hyprpanel.v1.HostService.NotificationAction:output_type -> hyprpanel.v1.HostServiceNotificationActionResponse
-	34, // 57: hyprpanel.v1.HostService.AudioSinkVolumeAdjust:output_type -> hyprpanel.v1.HostServiceAudioSinkVolumeAdjustResponse
-	36, // 58: hyprpanel.v1.HostService.AudioSinkMuteToggle:output_type -> hyprpanel.v1.HostServiceAudioSinkMuteToggleResponse
-	38, // 59: hyprpanel.v1.HostService.AudioSourceVolumeAdjust:output_type -> hyprpanel.v1.HostServiceAudioSourceVolumeAdjustResponse
-	40, // 60: hyprpanel.v1.HostService.AudioSourceMuteToggle:output_type -> hyprpanel.v1.HostServiceAudioSourceMuteToggleResponse
-	42, // 61: hyprpanel.v1.HostService.BrightnessAdjust:output_type -> hyprpanel.v1.HostServiceBrightnessAdjustResponse
-	46, // 62: hyprpanel.v1.HostService.CaptureFrame:output_type -> hyprpanel.v1.HostServiceCaptureFrameResponse
-	45, // 63: hyprpanel.v1.HostService.IdleInhibitorInhibit:output_type -> hyprpanel.v1.HostServiceIdleInhibitorResponse
-	45, // 64: hyprpanel.v1.HostService.IdleInhibitorUninhibit:output_type -> hyprpanel.v1.HostServiceIdleInhibitorResponse
-	50, // 65: hyprpanel.v1.HostService.MediaPlayerPlayPause:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
-	50, // 66: hyprpanel.v1.HostService.MediaPlayerPlay:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
-	50, // 67: hyprpanel.v1.HostService.MediaPlayerPause:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
-	50, // 68: hyprpanel.v1.HostService.MediaPlayerStop:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
-	50, // 69: hyprpanel.v1.HostService.MediaPlayerNext:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
-	50, // 70: hyprpanel.v1.HostService.MediaPlayerPrevious:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
-	50, // 71: hyprpanel.v1.HostService.MediaPlayerSeek:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
-	50, // 72: hyprpanel.v1.HostService.MediaPlayerSetPosition:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
-	44, // [44:73] is the sub-list for method output_type
-	15, // [15:44] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	78, // 0: hyprpanel.v1.AppInfo.actions:type_name -> hyprpanel.v1.AppInfo.Action
+	80, // 1: hyprpanel.v1.Capabilities.event_kinds:type_name -> hyprpanel.event.v1.EventKind
+	81, // 2: hyprpanel.v1.PanelServiceInitRequest.log_level:type_name -> hyprpanel.config.v1.LogLevel
+	82, // 3: hyprpanel.v1.PanelServiceInitRequest.config:type_name -> hyprpanel.config.v1.Panel
+	6,  // 4: hyprpanel.v1.PanelServiceInitRequest.capabilities:type_name -> hyprpanel.v1.Capabilities
+	9,  // 5: hyprpanel.v1.PanelServiceInitResponse.event_filter:type_name -> hyprpanel.v1.EventFilter
+	6,  // 6: hyprpanel.v1.PanelServiceInitResponse.capabilities:type_name -> hyprpanel.v1.Capabilities
+	80, // 7: hyprpanel.v1.EventFilter.kinds:type_name -> hyprpanel.event.v1.EventKind
+	83, // 8: hyprpanel.v1.PanelServiceNotifyRequest.event:type_name -> hyprpanel.event.v1.Event
+	83, // 9: hyprpanel.v1.PanelServiceNotifyStreamRequest.events:type_name -> hyprpanel.event.v1.Event
+	78, // 10: hyprpanel.v1.HostServiceExecRequest.action:type_name -> hyprpanel.v1.AppInfo.Action
+	5,  // 11: hyprpanel.v1.HostServiceFindApplicationResponse.app_info:type_name -> hyprpanel.v1.AppInfo
+	0,  // 12: hyprpanel.v1.HostServiceSystrayScrollRequest.orientation:type_name -> hyprpanel.v1.SystrayScrollOrientation
+	1,  // 13: hyprpanel.v1.HostServiceSystrayMenuEventRequest.event_id:type_name -> hyprpanel.v1.SystrayMenuEvent
+	84, // 14: hyprpanel.v1.HostServiceSystrayMenuEventRequest.data:type_name -> google.protobuf.Any
+	2,  // 15: hyprpanel.v1.HostServiceNotificationClosedRequest.reason:type_name -> hyprpanel.v1.NotificationClosedReason
+	85, // 16: hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
+	85, // 17: hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
+	85, // 18: hyprpanel.v1.HostServiceBrightnessAdjustRequest.direction:type_name -> hyprpanel.event.v1.Direction
+	86, // 19: hyprpanel.v1.HostServiceIdleInhibitorRequest.target:type_name -> hyprpanel.event.v1.InhibitTarget
+	4,  // 20: hyprpanel.v1.HostServiceCaptureFrameResponse.image:type_name -> hyprpanel.v1.ImageNRGBA
+	3,  // 21: hyprpanel.v1.HostServiceHyprQueryRequest.query:type_name -> hyprpanel.v1.HyprQuery
+	79, // 22: hyprpanel.v1.WidgetServiceInitRequest.config:type_name -> hyprpanel.v1.WidgetServiceInitRequest.ConfigEntry
+	87, // 23: hyprpanel.v1.WidgetServiceWatchResponse.root:type_name -> hyprpanel.widget.v1.Node
+	88, // 24: hyprpanel.v1.WidgetServiceClickRequest.button:type_name -> hyprpanel.widget.v1.Button
+	85, // 25: hyprpanel.v1.WidgetServiceScrollRequest.direction:type_name -> hyprpanel.event.v1.Direction
+	89, // 26: hyprpanel.v1.ControlServiceEventsResponse.event:type_name -> hyprpanel.event.v1.RecordedEvent
+	7,  // 27: hyprpanel.v1.PanelService.Init:input_type -> hyprpanel.v1.PanelServiceInitRequest
+	10, // 28: hyprpanel.v1.PanelService.Notify:input_type -> hyprpanel.v1.PanelServiceNotifyRequest
+	12, // 29: hyprpanel.v1.PanelService.NotifyStream:input_type -> hyprpanel.v1.PanelServiceNotifyStreamRequest
+	14, // 30: hyprpanel.v1.PanelService.UpdateStyle:input_type -> hyprpanel.v1.PanelServiceUpdateStyleRequest
+	18, // 31: hyprpanel.v1.PanelService.Close:input_type -> hyprpanel.v1.PanelServiceCloseRequest
+	20, // 32: hyprpanel.v1.HostService.Exec:input_type -> hyprpanel.v1.HostServiceExecRequest
+	22, // 33: hyprpanel.v1.HostService.FindApplication:input_type -> hyprpanel.v1.HostServiceFindApplicationRequest
+	24, // 34: hyprpanel.v1.HostService.SystrayActivate:input_type -> hyprpanel.v1.HostServiceSystrayActivateRequest
+	26, // 35: hyprpanel.v1.HostService.SystraySecondaryActivate:input_type -> hyprpanel.v1.HostServiceSystraySecondaryActivateRequest
+	28, // 36: hyprpanel.v1.HostService.SystrayScroll:input_type -> hyprpanel.v1.HostServiceSystrayScrollRequest
+	30, // 37: hyprpanel.v1.HostService.SystrayMenuContextActivate:input_type -> hyprpanel.v1.HostServiceSystrayMenuContextActivateRequest
+	32, // 38: hyprpanel.v1.HostService.SystrayMenuAboutToShow:input_type -> hyprpanel.v1.HostServiceSystrayMenuAboutToShowRequest
+	34, // 39: hyprpanel.v1.HostService.SystrayMenuEvent:input_type -> hyprpanel.v1.HostServiceSystrayMenuEventRequest
+	36, // 40: hyprpanel.v1.HostService.NotificationClosed:input_type -> hyprpanel.v1.HostServiceNotificationClosedRequest
+	38, // 41: hyprpanel.v1.HostService.NotificationAction:input_type -> hyprpanel.v1.HostServiceNotificationActionRequest
+	40, // 42: hyprpanel.v1.HostService.AudioSinkVolumeAdjust:input_type -> hyprpanel.v1.HostServiceAudioSinkVolumeAdjustRequest
+	42, // 43: hyprpanel.v1.HostService.AudioSinkMuteToggle:input_type -> hyprpanel.v1.HostServiceAudioSinkMuteToggleRequest
+	44, // 44: hyprpanel.v1.HostService.AudioSourceVolumeAdjust:input_type -> hyprpanel.v1.HostServiceAudioSourceVolumeAdjustRequest
+	46, // 45: hyprpanel.v1.HostService.AudioSourceMuteToggle:input_type -> hyprpanel.v1.HostServiceAudioSourceMuteToggleRequest
+	48, // 46: hyprpanel.v1.HostService.BrightnessAdjust:input_type -> hyprpanel.v1.HostServiceBrightnessAdjustRequest
+	50, // 47: hyprpanel.v1.HostService.CaptureFrame:input_type -> hyprpanel.v1.HostServiceCaptureFrameRequest
+	51, // 48: hyprpanel.v1.HostService.IdleInhibitorInhibit:input_type -> hyprpanel.v1.HostServiceIdleInhibitorRequest
+	51, // 49: hyprpanel.v1.HostService.IdleInhibitorUninhibit:input_type -> hyprpanel.v1.HostServiceIdleInhibitorRequest
+	54, // 50: hyprpanel.v1.HostService.MediaPlayerPlayPause:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
+	54, // 51: hyprpanel.v1.HostService.MediaPlayerPlay:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
+	54, // 52: hyprpanel.v1.HostService.MediaPlayerPause:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
+	54, // 53: hyprpanel.v1.HostService.MediaPlayerStop:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
+	54, // 54: hyprpanel.v1.HostService.MediaPlayerNext:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
+	54, // 55: hyprpanel.v1.HostService.MediaPlayerPrevious:input_type -> hyprpanel.v1.HostServiceMediaPlayerRequest
+	55, // 56: hyprpanel.v1.HostService.MediaPlayerSeek:input_type -> hyprpanel.v1.HostServiceMediaPlayerSeekRequest
+	56, // 57: hyprpanel.v1.HostService.MediaPlayerSetPosition:input_type -> hyprpanel.v1.HostServiceMediaPlayerSetPostionRequest
+	58, // 58: hyprpanel.v1.HostService.HyprQuery:input_type -> hyprpanel.v1.HostServiceHyprQueryRequest
+	60, // 59: hyprpanel.v1.HostService.HyprDispatch:input_type -> hyprpanel.v1.HostServiceHyprDispatchRequest
+	62, // 60: hyprpanel.v1.WidgetService.Init:input_type -> hyprpanel.v1.WidgetServiceInitRequest
+	64, // 61: hyprpanel.v1.WidgetService.Watch:input_type -> hyprpanel.v1.WidgetServiceWatchRequest
+	66, // 62: hyprpanel.v1.WidgetService.Click:input_type -> hyprpanel.v1.WidgetServiceClickRequest
+	68, // 63: hyprpanel.v1.WidgetService.Scroll:input_type -> hyprpanel.v1.WidgetServiceScrollRequest
+	70, // 64: hyprpanel.v1.WidgetService.MenuActivate:input_type -> hyprpanel.v1.WidgetServiceMenuActivateRequest
+	72, // 65: hyprpanel.v1.ControlService.Reload:input_type -> hyprpanel.v1.ControlServiceReloadRequest
+	74, // 66: hyprpanel.v1.ControlService.Quit:input_type -> hyprpanel.v1.ControlServiceQuitRequest
+	76, // 67: hyprpanel.v1.ControlService.Events:input_type -> hyprpanel.v1.ControlServiceEventsRequest
+	8,  // 68: hyprpanel.v1.PanelService.Init:output_type -> hyprpanel.v1.PanelServiceInitResponse
+	11, // 69: hyprpanel.v1.PanelService.Notify:output_type -> hyprpanel.v1.PanelServiceNotifyResponse
+	13, // 70: hyprpanel.v1.PanelService.NotifyStream:output_type -> hyprpanel.v1.PanelServiceNotifyStreamResponse
+	15, // 71: hyprpanel.v1.PanelService.UpdateStyle:output_type -> hyprpanel.v1.PanelServiceUpdateStyleResponse
+	19, // 72: hyprpanel.v1.PanelService.Close:output_type -> hyprpanel.v1.PanelServiceCloseResponse
+	21, // 73: hyprpanel.v1.HostService.Exec:output_type -> hyprpanel.v1.HostServiceExecResponse
+	23, // 74: hyprpanel.v1.HostService.FindApplication:output_type -> hyprpanel.v1.HostServiceFindApplicationResponse
+	25, // 75: hyprpanel.v1.HostService.SystrayActivate:output_type -> hyprpanel.v1.HostServiceSystrayActivateResponse
+	27, // 76: hyprpanel.v1.HostService.SystraySecondaryActivate:output_type -> hyprpanel.v1.HostServiceSystraySecondaryActivateResponse
+	29, // 77: hyprpanel.v1.HostService.SystrayScroll:output_type -> hyprpanel.v1.HostServiceSystrayScrollResponse
+	31, // 78: hyprpanel.v1.HostService.SystrayMenuContextActivate:output_type -> hyprpanel.v1.HostServiceSystrayMenuContextActivateResponse
+	33, // 79: hyprpanel.v1.HostService.SystrayMenuAboutToShow:output_type -> hyprpanel.v1.HostServiceSystrayMenuAboutToShowResponse
+	35, // 80: hyprpanel.v1.HostService.SystrayMenuEvent:output_type -> hyprpanel.v1.HostServiceSystrayMenuEventResponse
+	37, // 81: hyprpanel.v1.HostService.NotificationClosed:output_type -> hyprpanel.v1.HostServiceNotificationClosedResponse
+	39, // 82: hyprpanel.v1.HostService.NotificationAction:output_type -> hyprpanel.v1.HostServiceNotificationActionResponse
+	41, // 83: hyprpanel.v1.HostService.AudioSinkVolumeAdjust:output_type -> hyprpanel.v1.HostServiceAudioSinkVolumeAdjustResponse
+	43, // 84: hyprpanel.v1.HostService.AudioSinkMuteToggle:output_type -> hyprpanel.v1.HostServiceAudioSinkMuteToggleResponse
+	45, // 85: hyprpanel.v1.HostService.AudioSourceVolumeAdjust:output_type -> hyprpanel.v1.HostServiceAudioSourceVolumeAdjustResponse
+	47, // 86: hyprpanel.v1.HostService.AudioSourceMuteToggle:output_type -> hyprpanel.v1.HostServiceAudioSourceMuteToggleResponse
+	49, // 87: hyprpanel.v1.HostService.BrightnessAdjust:output_type -> hyprpanel.v1.HostServiceBrightnessAdjustResponse
+	53, // 88: hyprpanel.v1.HostService.CaptureFrame:output_type -> hyprpanel.v1.HostServiceCaptureFrameResponse
+	52, // 89: hyprpanel.v1.HostService.IdleInhibitorInhibit:output_type -> hyprpanel.v1.HostServiceIdleInhibitorResponse
+	52, // 90: hyprpanel.v1.HostService.IdleInhibitorUninhibit:output_type -> hyprpanel.v1.HostServiceIdleInhibitorResponse
+	57, // 91: hyprpanel.v1.HostService.MediaPlayerPlayPause:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
+	57, // 92: hyprpanel.v1.HostService.MediaPlayerPlay:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
+	57, // 93: hyprpanel.v1.HostService.MediaPlayerPause:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
+	57, // 94: hyprpanel.v1.HostService.MediaPlayerStop:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
+	57, // 95: hyprpanel.v1.HostService.MediaPlayerNext:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
+	57, // 96: hyprpanel.v1.HostService.MediaPlayerPrevious:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
+	57, // 97: hyprpanel.v1.HostService.MediaPlayerSeek:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
+	57, // 98: hyprpanel.v1.HostService.MediaPlayerSetPosition:output_type -> hyprpanel.v1.HostServiceMediaPlayerResponse
+	59, // 99: hyprpanel.v1.HostService.HyprQuery:output_type -> hyprpanel.v1.HostServiceHyprQueryResponse
+	61, // 100: hyprpanel.v1.HostService.HyprDispatch:output_type -> hyprpanel.v1.HostServiceHyprDispatchResponse
+	63, // 101: hyprpanel.v1.WidgetService.Init:output_type -> hyprpanel.v1.WidgetServiceInitResponse
+	65, // 102: hyprpanel.v1.WidgetService.Watch:output_type -> hyprpanel.v1.WidgetServiceWatchResponse
+	67, // 103: hyprpanel.v1.WidgetService.Click:output_type -> hyprpanel.v1.WidgetServiceClickResponse
+	69, // 104: hyprpanel.v1.WidgetService.Scroll:output_type -> hyprpanel.v1.WidgetServiceScrollResponse
+	71, // 105: hyprpanel.v1.WidgetService.MenuActivate:output_type -> hyprpanel.v1.WidgetServiceMenuActivateResponse
+	73, // 106: hyprpanel.v1.ControlService.Reload:output_type -> hyprpanel.v1.ControlServiceReloadResponse
+	75, // 107: hyprpanel.v1.ControlService.Quit:output_type -> hyprpanel.v1.ControlServiceQuitResponse
+	77, // 108: hyprpanel.v1.ControlService.Events:output_type -> hyprpanel.v1.ControlServiceEventsResponse
+	68, // [68:109] is the sub-list for method output_type
+	27, // [27:68] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_hyprpanel_v1_hyprpanel_proto_init() }
@@ -3283,8 +4851,212 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppInfo); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Capabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceInitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceInitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceNotifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceNotifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceNotifyStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceNotifyStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceUpdateStyleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceUpdateStyleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceNotificationCloseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceNotificationCloseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceCloseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanelServiceCloseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceExecRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceExecResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceFindApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3295,8 +5067,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceInitRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceFindApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3307,8 +5079,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceInitResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayActivateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3319,8 +5091,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceNotifyRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayActivateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3331,8 +5103,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceNotifyResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystraySecondaryActivateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3343,8 +5115,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceNotificationCloseRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystraySecondaryActivateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3355,8 +5127,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceNotificationCloseResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayScrollRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3367,8 +5139,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceCloseRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayScrollResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3379,8 +5151,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanelServiceCloseResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayMenuContextActivateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3391,8 +5163,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceExecRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayMenuContextActivateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3403,8 +5175,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceExecResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayMenuAboutToShowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3415,8 +5187,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceFindApplicationRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayMenuAboutToShowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3427,8 +5199,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceFindApplicationResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayMenuEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3439,8 +5211,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayActivateRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceSystrayMenuEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3451,8 +5223,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayActivateResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceNotificationClosedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3463,8 +5235,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystraySecondaryActivateRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceNotificationClosedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3475,8 +5247,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystraySecondaryActivateResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceNotificationActionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3487,8 +5259,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayScrollRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceNotificationActionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3499,8 +5271,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayScrollResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSinkVolumeAdjustRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3511,8 +5283,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuContextActivateRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSinkVolumeAdjustResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3523,8 +5295,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuContextActivateResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSinkMuteToggleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3535,8 +5307,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuAboutToShowRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSinkMuteToggleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3547,8 +5319,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuAboutToShowResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSourceVolumeAdjustRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3559,8 +5331,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuEventRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSourceVolumeAdjustResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3571,8 +5343,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceSystrayMenuEventResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSourceMuteToggleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3583,8 +5355,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceNotificationClosedRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceAudioSourceMuteToggleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3595,8 +5367,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceNotificationClosedResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceBrightnessAdjustRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3607,8 +5379,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceNotificationActionRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceBrightnessAdjustResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3619,8 +5391,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceNotificationActionResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceCaptureFrameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3631,8 +5403,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSinkVolumeAdjustRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceIdleInhibitorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3643,8 +5415,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSinkVolumeAdjustResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceIdleInhibitorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3655,8 +5427,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSinkMuteToggleRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceCaptureFrameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3667,8 +5439,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSinkMuteToggleResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceMediaPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3679,8 +5451,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSourceVolumeAdjustRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceMediaPlayerSeekRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3691,8 +5463,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSourceVolumeAdjustResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceMediaPlayerSetPostionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3703,8 +5475,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSourceMuteToggleRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceMediaPlayerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3715,8 +5487,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceAudioSourceMuteToggleResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceHyprQueryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3727,8 +5499,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceBrightnessAdjustRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceHyprQueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3739,8 +5511,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceBrightnessAdjustResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceHyprDispatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3751,8 +5523,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceCaptureFrameRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostServiceHyprDispatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3763,8 +5535,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceIdleInhibitorRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceInitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3775,8 +5547,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceIdleInhibitorResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceInitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3787,8 +5559,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceCaptureFrameResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceWatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3799,8 +5571,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceMediaPlayerRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceWatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3811,8 +5583,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceMediaPlayerSeekRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceClickRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3823,8 +5595,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceMediaPlayerSetPostionRequest); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceClickResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3835,8 +5607,8 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostServiceMediaPlayerResponse); i {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceScrollRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3847,7 +5619,115 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 				return nil
 			}
 		}
-		file_hyprpanel_v1_hyprpanel_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceScrollResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceMenuActivateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WidgetServiceMenuActivateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceReloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceReloadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceQuitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceQuitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlServiceEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_v1_hyprpanel_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AppInfo_Action); i {
 			case 0:
 				return &v.state
@@ -3865,10 +5745,10 @@ func file_hyprpanel_v1_hyprpanel_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hyprpanel_v1_hyprpanel_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   49,
+			NumEnums:      4,
+			NumMessages:   76,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   4,
 		},
 		GoTypes:           file_hyprpanel_v1_hyprpanel_proto_goTypes,
 		DependencyIndexes: file_hyprpanel_v1_hyprpanel_proto_depIdxs,
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PanelService_Init_FullMethodName   = "/hyprpanel.v1.PanelService/Init"
-	PanelService_Notify_FullMethodName = "/hyprpanel.v1.PanelService/Notify"
-	PanelService_Close_FullMethodName  = "/hyprpanel.v1.PanelService/Close"
+	PanelService_Init_FullMethodName         = "/hyprpanel.v1.PanelService/Init"
+	PanelService_Notify_FullMethodName       = "/hyprpanel.v1.PanelService/Notify"
+	PanelService_NotifyStream_FullMethodName = "/hyprpanel.v1.PanelService/NotifyStream"
+	PanelService_UpdateStyle_FullMethodName  = "/hyprpanel.v1.PanelService/UpdateStyle"
+	PanelService_Close_FullMethodName        = "/hyprpanel.v1.PanelService/Close"
 )
 
 // PanelServiceClient is the client API for PanelService service.
@@ -30,6 +32,8 @@ const (
 type PanelServiceClient interface {
 	Init(ctx context.Context, in *PanelServiceInitRequest, opts ...grpc.CallOption) (*PanelServiceInitResponse, error)
 	Notify(ctx context.Context, in *PanelServiceNotifyRequest, opts ...grpc.CallOption) (*PanelServiceNotifyResponse, error)
+	NotifyStream(ctx context.Context, opts ...grpc.CallOption) (PanelService_NotifyStreamClient, error)
+	UpdateStyle(ctx context.Context, in *PanelServiceUpdateStyleRequest, opts ...grpc.CallOption) (*PanelServiceUpdateStyleResponse, error)
 	Close(ctx context.Context, in *PanelServiceCloseRequest, opts ...grpc.CallOption) (*PanelServiceCloseResponse, error)
 }
 
@@ -59,6 +63,46 @@ func (c *panelServiceClient) Notify(ctx context.Context, in *PanelServiceNotifyR
 	return out, nil
 }
 
+func (c *panelServiceClient) NotifyStream(ctx context.Context, opts ...grpc.CallOption) (PanelService_NotifyStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PanelService_ServiceDesc.Streams[0], PanelService_NotifyStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &panelServiceNotifyStreamClient{stream}
+	return x, nil
+}
+
+type PanelService_NotifyStreamClient interface {
+	Send(*PanelServiceNotifyStreamRequest) error
+	Recv() (*PanelServiceNotifyStreamResponse, error)
+	grpc.ClientStream
+}
+
+type panelServiceNotifyStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *panelServiceNotifyStreamClient) Send(m *PanelServiceNotifyStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *panelServiceNotifyStreamClient) Recv() (*PanelServiceNotifyStreamResponse, error) {
+	m := new(PanelServiceNotifyStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *panelServiceClient) UpdateStyle(ctx context.Context, in *PanelServiceUpdateStyleRequest, opts ...grpc.CallOption) (*PanelServiceUpdateStyleResponse, error) {
+	out := new(PanelServiceUpdateStyleResponse)
+	err := c.cc.Invoke(ctx, PanelService_UpdateStyle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *panelServiceClient) Close(ctx context.Context, in *PanelServiceCloseRequest, opts ...grpc.CallOption) (*PanelServiceCloseResponse, error) {
 	out := new(PanelServiceCloseResponse)
 	err := c.cc.Invoke(ctx, PanelService_Close_FullMethodName, in, out, opts...)
@@ -74,6 +118,8 @@ func (c *panelServiceClient) Close(ctx context.Context, in *PanelServiceCloseReq
 type PanelServiceServer interface {
 	Init(context.Context, *PanelServiceInitRequest) (*PanelServiceInitResponse, error)
 	Notify(context.Context, *PanelServiceNotifyRequest) (*PanelServiceNotifyResponse, error)
+	NotifyStream(PanelService_NotifyStreamServer) error
+	UpdateStyle(context.Context, *PanelServiceUpdateStyleRequest) (*PanelServiceUpdateStyleResponse, error)
 	Close(context.Context, *PanelServiceCloseRequest) (*PanelServiceCloseResponse, error)
 	mustEmbedUnimplementedPanelServiceServer()
 }
@@ -88,6 +134,12 @@ func (UnimplementedPanelServiceServer) Init(context.Context, *PanelServiceInitRe
 func (UnimplementedPanelServiceServer) Notify(context.Context, *PanelServiceNotifyRequest) (*PanelServiceNotifyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Notify not implemented")
 }
+func (UnimplementedPanelServiceServer) NotifyStream(PanelService_NotifyStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method NotifyStream not implemented")
+}
+func (UnimplementedPanelServiceServer) UpdateStyle(context.Context, *PanelServiceUpdateStyleRequest) (*PanelServiceUpdateStyleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStyle not implemented")
+}
 func (UnimplementedPanelServiceServer) Close(context.Context, *PanelServiceCloseRequest) (*PanelServiceCloseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
 }
@@ -140,6 +192,50 @@ func _PanelService_Notify_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PanelService_NotifyStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PanelServiceServer).NotifyStream(&panelServiceNotifyStreamServer{stream})
+}
+
+type PanelService_NotifyStreamServer interface {
+	Send(*PanelServiceNotifyStreamResponse) error
+	Recv() (*PanelServiceNotifyStreamRequest, error)
+	grpc.ServerStream
+}
+
+type panelServiceNotifyStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *panelServiceNotifyStreamServer) Send(m *PanelServiceNotifyStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *panelServiceNotifyStreamServer) Recv() (*PanelServiceNotifyStreamRequest, error) {
+	m := new(PanelServiceNotifyStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PanelService_UpdateStyle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PanelServiceUpdateStyleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanelServiceServer).UpdateStyle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PanelService_UpdateStyle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanelServiceServer).UpdateStyle(ctx, req.(*PanelServiceUpdateStyleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PanelService_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PanelServiceCloseRequest)
 	if err := dec(in); err != nil {
@@ -173,12 +269,23 @@ var PanelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Notify",
 			Handler:    _PanelService_Notify_Handler,
 		},
+		{
+			MethodName: "UpdateStyle",
+			Handler:    _PanelService_UpdateStyle_Handler,
+		},
 		{
 			MethodName: "Close",
 			Handler:    _PanelService_Close_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "NotifyStream",
+			Handler:       _PanelService_NotifyStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "hyprpanel/v1/hyprpanel.proto",
 }
 
@@ -209,6 +316,8 @@ const (
 	HostService_MediaPlayerPrevious_FullMethodName        = "/hyprpanel.v1.HostService/MediaPlayerPrevious"
 	HostService_MediaPlayerSeek_FullMethodName            = "/hyprpanel.v1.HostService/MediaPlayerSeek"
 	HostService_MediaPlayerSetPosition_FullMethodName     = "/hyprpanel.v1.HostService/MediaPlayerSetPosition"
+	HostService_HyprQuery_FullMethodName                  = "/hyprpanel.v1.HostService/HyprQuery"
+	HostService_HyprDispatch_FullMethodName               = "/hyprpanel.v1.HostService/HyprDispatch"
 )
 
 // HostServiceClient is the client API for HostService service.
@@ -241,6 +350,8 @@ type HostServiceClient interface {
 	MediaPlayerPrevious(ctx context.Context, in *HostServiceMediaPlayerRequest, opts ...grpc.CallOption) (*HostServiceMediaPlayerResponse, error)
 	MediaPlayerSeek(ctx context.Context, in *HostServiceMediaPlayerSeekRequest, opts ...grpc.CallOption) (*HostServiceMediaPlayerResponse, error)
 	MediaPlayerSetPosition(ctx context.Context, in *HostServiceMediaPlayerSetPostionRequest, opts ...grpc.CallOption) (*HostServiceMediaPlayerResponse, error)
+	HyprQuery(ctx context.Context, in *HostServiceHyprQueryRequest, opts ...grpc.CallOption) (*HostServiceHyprQueryResponse, error)
+	HyprDispatch(ctx context.Context, in *HostServiceHyprDispatchRequest, opts ...grpc.CallOption) (*HostServiceHyprDispatchResponse, error)
 }
 
 type hostServiceClient struct {
@@ -485,6 +596,24 @@ func (c *hostServiceClient) MediaPlayerSetPosition(ctx context.Context, in *Host
 	return out, nil
 }
 
+func (c *hostServiceClient) HyprQuery(ctx context.Context, in *HostServiceHyprQueryRequest, opts ...grpc.CallOption) (*HostServiceHyprQueryResponse, error) {
+	out := new(HostServiceHyprQueryResponse)
+	err := c.cc.Invoke(ctx, HostService_HyprQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostServiceClient) HyprDispatch(ctx context.Context, in *HostServiceHyprDispatchRequest, opts ...grpc.CallOption) (*HostServiceHyprDispatchResponse, error) {
+	out := new(HostServiceHyprDispatchResponse)
+	err := c.cc.Invoke(ctx, HostService_HyprDispatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HostServiceServer is the server API for HostService service.
 // All implementations must embed UnimplementedHostServiceServer
 // for forward compatibility
@@ -515,6 +644,8 @@ type HostServiceServer interface {
 	MediaPlayerPrevious(context.Context, *HostServiceMediaPlayerRequest) (*HostServiceMediaPlayerResponse, error)
 	MediaPlayerSeek(context.Context, *HostServiceMediaPlayerSeekRequest) (*HostServiceMediaPlayerResponse, error)
 	MediaPlayerSetPosition(context.Context, *HostServiceMediaPlayerSetPostionRequest) (*HostServiceMediaPlayerResponse, error)
+	HyprQuery(context.Context, *HostServiceHyprQueryRequest) (*HostServiceHyprQueryResponse, error)
+	HyprDispatch(context.Context, *HostServiceHyprDispatchRequest) (*HostServiceHyprDispatchResponse, error)
 	mustEmbedUnimplementedHostServiceServer()
 }
 
@@ -600,6 +731,12 @@ func (UnimplementedHostServiceServer) MediaPlayerSeek(context.Context, *HostServ
 func (UnimplementedHostServiceServer) MediaPlayerSetPosition(context.Context, *HostServiceMediaPlayerSetPostionRequest) (*HostServiceMediaPlayerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MediaPlayerSetPosition not implemented")
 }
+func (UnimplementedHostServiceServer) HyprQuery(context.Context, *HostServiceHyprQueryRequest) (*HostServiceHyprQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HyprQuery not implemented")
+}
+func (UnimplementedHostServiceServer) HyprDispatch(context.Context, *HostServiceHyprDispatchRequest) (*HostServiceHyprDispatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HyprDispatch not implemented")
+}
 func (UnimplementedHostServiceServer) mustEmbedUnimplementedHostServiceServer() {}
 
 // UnsafeHostServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1081,6 +1218,42 @@ func _HostService_MediaPlayerSetPosition_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostService_HyprQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostServiceHyprQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServiceServer).HyprQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HostService_HyprQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServiceServer).HyprQuery(ctx, req.(*HostServiceHyprQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostService_HyprDispatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostServiceHyprDispatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostServiceServer).HyprDispatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HostService_HyprDispatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostServiceServer).HyprDispatch(ctx, req.(*HostServiceHyprDispatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HostService_ServiceDesc is the grpc.ServiceDesc for HostService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1192,7 +1365,473 @@ var HostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MediaPlayerSetPosition",
 			Handler:    _HostService_MediaPlayerSetPosition_Handler,
 		},
+		{
+			MethodName: "HyprQuery",
+			Handler:    _HostService_HyprQuery_Handler,
+		},
+		{
+			MethodName: "HyprDispatch",
+			Handler:    _HostService_HyprDispatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hyprpanel/v1/hyprpanel.proto",
 }
+
+const (
+	WidgetService_Init_FullMethodName         = "/hyprpanel.v1.WidgetService/Init"
+	WidgetService_Watch_FullMethodName        = "/hyprpanel.v1.WidgetService/Watch"
+	WidgetService_Click_FullMethodName        = "/hyprpanel.v1.WidgetService/Click"
+	WidgetService_Scroll_FullMethodName       = "/hyprpanel.v1.WidgetService/Scroll"
+	WidgetService_MenuActivate_FullMethodName = "/hyprpanel.v1.WidgetService/MenuActivate"
+)
+
+// WidgetServiceClient is the client API for WidgetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WidgetServiceClient interface {
+	Init(ctx context.Context, in *WidgetServiceInitRequest, opts ...grpc.CallOption) (*WidgetServiceInitResponse, error)
+	Watch(ctx context.Context, in *WidgetServiceWatchRequest, opts ...grpc.CallOption) (WidgetService_WatchClient, error)
+	Click(ctx context.Context, in *WidgetServiceClickRequest, opts ...grpc.CallOption) (*WidgetServiceClickResponse, error)
+	Scroll(ctx context.Context, in *WidgetServiceScrollRequest, opts ...grpc.CallOption) (*WidgetServiceScrollResponse, error)
+	MenuActivate(ctx context.Context, in *WidgetServiceMenuActivateRequest, opts ...grpc.CallOption) (*WidgetServiceMenuActivateResponse, error)
+}
+
+type widgetServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWidgetServiceClient(cc grpc.ClientConnInterface) WidgetServiceClient {
+	return &widgetServiceClient{cc}
+}
+
+func (c *widgetServiceClient) Init(ctx context.Context, in *WidgetServiceInitRequest, opts ...grpc.CallOption) (*WidgetServiceInitResponse, error) {
+	out := new(WidgetServiceInitResponse)
+	err := c.cc.Invoke(ctx, WidgetService_Init_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *widgetServiceClient) Watch(ctx context.Context, in *WidgetServiceWatchRequest, opts ...grpc.CallOption) (WidgetService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WidgetService_ServiceDesc.Streams[0], WidgetService_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &widgetServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WidgetService_WatchClient interface {
+	Recv() (*WidgetServiceWatchResponse, error)
+	grpc.ClientStream
+}
+
+type widgetServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *widgetServiceWatchClient) Recv() (*WidgetServiceWatchResponse, error) {
+	m := new(WidgetServiceWatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *widgetServiceClient) Click(ctx context.Context, in *WidgetServiceClickRequest, opts ...grpc.CallOption) (*WidgetServiceClickResponse, error) {
+	out := new(WidgetServiceClickResponse)
+	err := c.cc.Invoke(ctx, WidgetService_Click_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *widgetServiceClient) Scroll(ctx context.Context, in *WidgetServiceScrollRequest, opts ...grpc.CallOption) (*WidgetServiceScrollResponse, error) {
+	out := new(WidgetServiceScrollResponse)
+	err := c.cc.Invoke(ctx, WidgetService_Scroll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *widgetServiceClient) MenuActivate(ctx context.Context, in *WidgetServiceMenuActivateRequest, opts ...grpc.CallOption) (*WidgetServiceMenuActivateResponse, error) {
+	out := new(WidgetServiceMenuActivateResponse)
+	err := c.cc.Invoke(ctx, WidgetService_MenuActivate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WidgetServiceServer is the server API for WidgetService service.
+// All implementations must embed UnimplementedWidgetServiceServer
+// for forward compatibility
+type WidgetServiceServer interface {
+	Init(context.Context, *WidgetServiceInitRequest) (*WidgetServiceInitResponse, error)
+	Watch(*WidgetServiceWatchRequest, WidgetService_WatchServer) error
+	Click(context.Context, *WidgetServiceClickRequest) (*WidgetServiceClickResponse, error)
+	Scroll(context.Context, *WidgetServiceScrollRequest) (*WidgetServiceScrollResponse, error)
+	MenuActivate(context.Context, *WidgetServiceMenuActivateRequest) (*WidgetServiceMenuActivateResponse, error)
+	mustEmbedUnimplementedWidgetServiceServer()
+}
+
+// UnimplementedWidgetServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWidgetServiceServer struct {
+}
+
+func (UnimplementedWidgetServiceServer) Init(context.Context, *WidgetServiceInitRequest) (*WidgetServiceInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (UnimplementedWidgetServiceServer) Watch(*WidgetServiceWatchRequest, WidgetService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedWidgetServiceServer) Click(context.Context, *WidgetServiceClickRequest) (*WidgetServiceClickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Click not implemented")
+}
+func (UnimplementedWidgetServiceServer) Scroll(context.Context, *WidgetServiceScrollRequest) (*WidgetServiceScrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scroll not implemented")
+}
+func (UnimplementedWidgetServiceServer) MenuActivate(context.Context, *WidgetServiceMenuActivateRequest) (*WidgetServiceMenuActivateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MenuActivate not implemented")
+}
+func (UnimplementedWidgetServiceServer) mustEmbedUnimplementedWidgetServiceServer() {}
+
+// UnsafeWidgetServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WidgetServiceServer will
+// result in compilation errors.
+type UnsafeWidgetServiceServer interface {
+	mustEmbedUnimplementedWidgetServiceServer()
+}
+
+func RegisterWidgetServiceServer(s grpc.ServiceRegistrar, srv WidgetServiceServer) {
+	s.RegisterService(&WidgetService_ServiceDesc, srv)
+}
+
+func _WidgetService_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WidgetServiceInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WidgetServiceServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WidgetService_Init_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WidgetServiceServer).Init(ctx, req.(*WidgetServiceInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WidgetService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WidgetServiceWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WidgetServiceServer).Watch(m, &widgetServiceWatchServer{stream})
+}
+
+type WidgetService_WatchServer interface {
+	Send(*WidgetServiceWatchResponse) error
+	grpc.ServerStream
+}
+
+type widgetServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *widgetServiceWatchServer) Send(m *WidgetServiceWatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WidgetService_Click_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WidgetServiceClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WidgetServiceServer).Click(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WidgetService_Click_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WidgetServiceServer).Click(ctx, req.(*WidgetServiceClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WidgetService_Scroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WidgetServiceScrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WidgetServiceServer).Scroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WidgetService_Scroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WidgetServiceServer).Scroll(ctx, req.(*WidgetServiceScrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WidgetService_MenuActivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WidgetServiceMenuActivateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WidgetServiceServer).MenuActivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WidgetService_MenuActivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WidgetServiceServer).MenuActivate(ctx, req.(*WidgetServiceMenuActivateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WidgetService_ServiceDesc is the grpc.ServiceDesc for WidgetService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WidgetService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hyprpanel.v1.WidgetService",
+	HandlerType: (*WidgetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Init",
+			Handler:    _WidgetService_Init_Handler,
+		},
+		{
+			MethodName: "Click",
+			Handler:    _WidgetService_Click_Handler,
+		},
+		{
+			MethodName: "Scroll",
+			Handler:    _WidgetService_Scroll_Handler,
+		},
+		{
+			MethodName: "MenuActivate",
+			Handler:    _WidgetService_MenuActivate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _WidgetService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hyprpanel/v1/hyprpanel.proto",
+}
+
+const (
+	ControlService_Reload_FullMethodName = "/hyprpanel.v1.ControlService/Reload"
+	ControlService_Quit_FullMethodName   = "/hyprpanel.v1.ControlService/Quit"
+	ControlService_Events_FullMethodName = "/hyprpanel.v1.ControlService/Events"
+)
+
+// ControlServiceClient is the client API for ControlService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ControlServiceClient interface {
+	Reload(ctx context.Context, in *ControlServiceReloadRequest, opts ...grpc.CallOption) (*ControlServiceReloadResponse, error)
+	Quit(ctx context.Context, in *ControlServiceQuitRequest, opts ...grpc.CallOption) (*ControlServiceQuitResponse, error)
+	Events(ctx context.Context, in *ControlServiceEventsRequest, opts ...grpc.CallOption) (ControlService_EventsClient, error)
+}
+
+type controlServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewControlServiceClient(cc grpc.ClientConnInterface) ControlServiceClient {
+	return &controlServiceClient{cc}
+}
+
+func (c *controlServiceClient) Reload(ctx context.Context, in *ControlServiceReloadRequest, opts ...grpc.CallOption) (*ControlServiceReloadResponse, error) {
+	out := new(ControlServiceReloadResponse)
+	err := c.cc.Invoke(ctx, ControlService_Reload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) Quit(ctx context.Context, in *ControlServiceQuitRequest, opts ...grpc.CallOption) (*ControlServiceQuitResponse, error) {
+	out := new(ControlServiceQuitResponse)
+	err := c.cc.Invoke(ctx, ControlService_Quit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) Events(ctx context.Context, in *ControlServiceEventsRequest, opts ...grpc.CallOption) (ControlService_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ControlService_ServiceDesc.Streams[0], ControlService_Events_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controlServiceEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ControlService_EventsClient interface {
+	Recv() (*ControlServiceEventsResponse, error)
+	grpc.ClientStream
+}
+
+type controlServiceEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *controlServiceEventsClient) Recv() (*ControlServiceEventsResponse, error) {
+	m := new(ControlServiceEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ControlServiceServer is the server API for ControlService service.
+// All implementations must embed UnimplementedControlServiceServer
+// for forward compatibility
+type ControlServiceServer interface {
+	Reload(context.Context, *ControlServiceReloadRequest) (*ControlServiceReloadResponse, error)
+	Quit(context.Context, *ControlServiceQuitRequest) (*ControlServiceQuitResponse, error)
+	Events(*ControlServiceEventsRequest, ControlService_EventsServer) error
+	mustEmbedUnimplementedControlServiceServer()
+}
+
+// UnimplementedControlServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedControlServiceServer struct {
+}
+
+func (UnimplementedControlServiceServer) Reload(context.Context, *ControlServiceReloadRequest) (*ControlServiceReloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reload not implemented")
+}
+func (UnimplementedControlServiceServer) Quit(context.Context, *ControlServiceQuitRequest) (*ControlServiceQuitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quit not implemented")
+}
+func (UnimplementedControlServiceServer) Events(*ControlServiceEventsRequest, ControlService_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedControlServiceServer) mustEmbedUnimplementedControlServiceServer() {}
+
+// UnsafeControlServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ControlServiceServer will
+// result in compilation errors.
+type UnsafeControlServiceServer interface {
+	mustEmbedUnimplementedControlServiceServer()
+}
+
+func RegisterControlServiceServer(s grpc.ServiceRegistrar, srv ControlServiceServer) {
+	s.RegisterService(&ControlService_ServiceDesc, srv)
+}
+
+func _ControlService_Reload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlServiceReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).Reload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_Reload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).Reload(ctx, req.(*ControlServiceReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_Quit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlServiceQuitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).Quit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlService_Quit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).Quit(ctx, req.(*ControlServiceQuitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ControlServiceEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlServiceServer).Events(m, &controlServiceEventsServer{stream})
+}
+
+type ControlService_EventsServer interface {
+	Send(*ControlServiceEventsResponse) error
+	grpc.ServerStream
+}
+
+type controlServiceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *controlServiceEventsServer) Send(m *ControlServiceEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ControlService_ServiceDesc is the grpc.ServiceDesc for ControlService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ControlService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hyprpanel.v1.ControlService",
+	HandlerType: (*ControlServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reload",
+			Handler:    _ControlService_Reload_Handler,
+		},
+		{
+			MethodName: "Quit",
+			Handler:    _ControlService_Quit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _ControlService_Events_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "hyprpanel/v1/hyprpanel.proto",
+}
@@ -0,0 +1,794 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: hyprpanel/widget/v1/widget.proto
+
+package widgetv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Orientation int32
+
+const (
+	Orientation_ORIENTATION_UNSPECIFIED Orientation = 0
+	Orientation_ORIENTATION_HORIZONTAL  Orientation = 1
+	Orientation_ORIENTATION_VERTICAL    Orientation = 2
+)
+
+// Enum value maps for Orientation.
+var (
+	Orientation_name = map[int32]string{
+		0: "ORIENTATION_UNSPECIFIED",
+		1: "ORIENTATION_HORIZONTAL",
+		2: "ORIENTATION_VERTICAL",
+	}
+	Orientation_value = map[string]int32{
+		"ORIENTATION_UNSPECIFIED": 0,
+		"ORIENTATION_HORIZONTAL":  1,
+		"ORIENTATION_VERTICAL":    2,
+	}
+)
+
+func (x Orientation) Enum() *Orientation {
+	p := new(Orientation)
+	*p = x
+	return p
+}
+
+func (x Orientation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Orientation) Descriptor() protoreflect.EnumDescriptor {
+	return file_hyprpanel_widget_v1_widget_proto_enumTypes[0].Descriptor()
+}
+
+func (Orientation) Type() protoreflect.EnumType {
+	return &file_hyprpanel_widget_v1_widget_proto_enumTypes[0]
+}
+
+func (x Orientation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Orientation.Descriptor instead.
+func (Orientation) EnumDescriptor() ([]byte, []int) {
+	return file_hyprpanel_widget_v1_widget_proto_rawDescGZIP(), []int{0}
+}
+
+type Button int32
+
+const (
+	Button_BUTTON_UNSPECIFIED Button = 0
+	Button_BUTTON_PRIMARY     Button = 1
+	Button_BUTTON_MIDDLE      Button = 2
+	Button_BUTTON_SECONDARY   Button = 3
+)
+
+// Enum value maps for Button.
+var (
+	Button_name = map[int32]string{
+		0: "BUTTON_UNSPECIFIED",
+		1: "BUTTON_PRIMARY",
+		2: "BUTTON_MIDDLE",
+		3: "BUTTON_SECONDARY",
+	}
+	Button_value = map[string]int32{
+		"BUTTON_UNSPECIFIED": 0,
+		"BUTTON_PRIMARY":     1,
+		"BUTTON_MIDDLE":      2,
+		"BUTTON_SECONDARY":   3,
+	}
+)
+
+func (x Button) Enum() *Button {
+	p := new(Button)
+	*p = x
+	return p
+}
+
+func (x Button) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Button) Descriptor() protoreflect.EnumDescriptor {
+	return file_hyprpanel_widget_v1_widget_proto_enumTypes[1].Descriptor()
+}
+
+func (Button) Type() protoreflect.EnumType {
+	return &file_hyprpanel_widget_v1_widget_proto_enumTypes[1]
+}
+
+func (x Button) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Button.Descriptor instead.
+func (Button) EnumDescriptor() ([]byte, []int) {
+	return file_hyprpanel_widget_v1_widget_proto_rawDescGZIP(), []int{1}
+}
+
+type Box struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orientation Orientation `protobuf:"varint,1,opt,name=orientation,proto3,enum=hyprpanel.widget.v1.Orientation" json:"orientation,omitempty"` // layout direction for children, unspecified follows the panel orientation.
+	Spacing     uint32      `protobuf:"varint,2,opt,name=spacing,proto3" json:"spacing,omitempty"`                                              // space in pixels between children.
+	Children    []*Node     `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`                                             // child nodes.
+}
+
+func (x *Box) Reset() {
+	*x = Box{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Box) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Box) ProtoMessage() {}
+
+func (x *Box) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Box.ProtoReflect.Descriptor instead.
+func (*Box) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_widget_v1_widget_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Box) GetOrientation() Orientation {
+	if x != nil {
+		return x.Orientation
+	}
+	return Orientation_ORIENTATION_UNSPECIFIED
+}
+
+func (x *Box) GetSpacing() uint32 {
+	if x != nil {
+		return x.Spacing
+	}
+	return 0
+}
+
+func (x *Box) GetChildren() []*Node {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type Label struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text   string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`      // label text.
+	Markup bool   `protobuf:"varint,2,opt,name=markup,proto3" json:"markup,omitempty"` // interpret text as Pango markup.
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_widget_v1_widget_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Label) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Label) GetMarkup() bool {
+	if x != nil {
+		return x.Markup
+	}
+	return false
+}
+
+type Icon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`          // icon name from the current theme, or absolute path to an icon file.
+	Size     uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`         // size in pixels for the icon.
+	Symbolic bool   `protobuf:"varint,3,opt,name=symbolic,proto3" json:"symbolic,omitempty"` // display symbolic or coloured icon.
+}
+
+func (x *Icon) Reset() {
+	*x = Icon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Icon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Icon) ProtoMessage() {}
+
+func (x *Icon) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Icon.ProtoReflect.Descriptor instead.
+func (*Icon) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_widget_v1_widget_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Icon) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Icon) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Icon) GetSymbolic() bool {
+	if x != nil {
+		return x.Symbolic
+	}
+	return false
+}
+
+type Progress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fraction float64 `protobuf:"fixed64,1,opt,name=fraction,proto3" json:"fraction,omitempty"` // fraction of the bar that is filled, from 0.0 to 1.0.
+}
+
+func (x *Progress) Reset() {
+	*x = Progress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Progress) ProtoMessage() {}
+
+func (x *Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Progress.ProtoReflect.Descriptor instead.
+func (*Progress) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_widget_v1_widget_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Progress) GetFraction() float64 {
+	if x != nil {
+		return x.Fraction
+	}
+	return 0
+}
+
+type MenuItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`              // identifier passed back to the widget when the item is activated.
+	Label    string      `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`        // item label.
+	Disabled bool        `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"` // display the item as insensitive.
+	Children []*MenuItem `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`  // items displayed as a submenu of this item.
+}
+
+func (x *MenuItem) Reset() {
+	*x = MenuItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItem) ProtoMessage() {}
+
+func (x *MenuItem) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuItem.ProtoReflect.Descriptor instead.
+func (*MenuItem) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_widget_v1_widget_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MenuItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuItem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MenuItem) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *MenuItem) GetChildren() []*MenuItem {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // identifier passed back to the widget on click and scroll. Nodes without an identifier do not receive input.
+	Tooltip    string      `protobuf:"bytes,2,opt,name=tooltip,proto3" json:"tooltip,omitempty"`                         // tooltip text, may include Pango markup.
+	CssClasses []string    `protobuf:"bytes,3,rep,name=css_classes,json=cssClasses,proto3" json:"css_classes,omitempty"` // list of CSS classes applied to the node.
+	Menu       []*MenuItem `protobuf:"bytes,4,rep,name=menu,proto3" json:"menu,omitempty"`                               // menu displayed on right click, replacing the secondary click callback for this node.
+	// Types that are assignable to Kind:
+	//
+	//	*Node_Box
+	//	*Node_Label
+	//	*Node_Icon
+	//	*Node_Progress
+	Kind isNode_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_hyprpanel_widget_v1_widget_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_hyprpanel_widget_v1_widget_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Node) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Node) GetTooltip() string {
+	if x != nil {
+		return x.Tooltip
+	}
+	return ""
+}
+
+func (x *Node) GetCssClasses() []string {
+	if x != nil {
+		return x.CssClasses
+	}
+	return nil
+}
+
+func (x *Node) GetMenu() []*MenuItem {
+	if x != nil {
+		return x.Menu
+	}
+	return nil
+}
+
+func (m *Node) GetKind() isNode_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Node) GetBox() *Box {
+	if x, ok := x.GetKind().(*Node_Box); ok {
+		return x.Box
+	}
+	return nil
+}
+
+func (x *Node) GetLabel() *Label {
+	if x, ok := x.GetKind().(*Node_Label); ok {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *Node) GetIcon() *Icon {
+	if x, ok := x.GetKind().(*Node_Icon); ok {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *Node) GetProgress() *Progress {
+	if x, ok := x.GetKind().(*Node_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+type isNode_Kind interface {
+	isNode_Kind()
+}
+
+type Node_Box struct {
+	Box *Box `protobuf:"bytes,5,opt,name=box,proto3,oneof"`
+}
+
+type Node_Label struct {
+	Label *Label `protobuf:"bytes,6,opt,name=label,proto3,oneof"`
+}
+
+type Node_Icon struct {
+	Icon *Icon `protobuf:"bytes,7,opt,name=icon,proto3,oneof"`
+}
+
+type Node_Progress struct {
+	Progress *Progress `protobuf:"bytes,8,opt,name=progress,proto3,oneof"`
+}
+
+func (*Node_Box) isNode_Kind() {}
+
+func (*Node_Label) isNode_Kind() {}
+
+func (*Node_Icon) isNode_Kind() {}
+
+func (*Node_Progress) isNode_Kind() {}
+
+var File_hyprpanel_widget_v1_widget_proto protoreflect.FileDescriptor
+
+var file_hyprpanel_widget_v1_widget_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x77, 0x69, 0x64, 0x67,
+	0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69,
+	0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x22, 0x9a, 0x01, 0x0a, 0x03, 0x42, 0x6f, 0x78, 0x12,
+	0x42, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c,
+	0x2e, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x69, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a,
+	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69, 0x64, 0x67,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x22, 0x33, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x75, 0x70, 0x22, 0x4a, 0x0a, 0x04, 0x49, 0x63, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x69, 0x63, 0x22, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01,
+	0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69, 0x64, 0x67, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0xdc, 0x02, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x73,
+	0x73, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x6d,
+	0x65, 0x6e, 0x75, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72,
+	0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x2c,
+	0x0a, 0x03, 0x62, 0x6f, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6f, 0x78, 0x48, 0x00, 0x52, 0x03, 0x62, 0x6f, 0x78, 0x12, 0x32, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x2f, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69, 0x64, 0x67, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x69, 0x63, 0x6f,
+	0x6e, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e,
+	0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x06,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x2a, 0x60, 0x0a, 0x0b, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x4f, 0x4e, 0x54, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x18,
+	0x0a, 0x14, 0x4f, 0x52, 0x49, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x45,
+	0x52, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x2a, 0x5d, 0x0a, 0x06, 0x42, 0x75, 0x74, 0x74,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x55, 0x54, 0x54, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x55,
+	0x54, 0x54, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x42, 0x55, 0x54, 0x54, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x44, 0x44, 0x4c, 0x45, 0x10,
+	0x02, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x55, 0x54, 0x54, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x43, 0x4f,
+	0x4e, 0x44, 0x41, 0x52, 0x59, 0x10, 0x03, 0x42, 0xd1, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
+	0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2e, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x64, 0x66, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x68, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x2f, 0x77, 0x69, 0x64,
+	0x67, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x77, 0x69, 0x64, 0x67, 0x65, 0x74, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x48, 0x57, 0x58, 0xaa, 0x02, 0x13, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65,
+	0x6c, 0x2e, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x48, 0x79,
+	0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1f, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x5c, 0x57, 0x69,
+	0x64, 0x67, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x48, 0x79, 0x70, 0x72, 0x70, 0x61, 0x6e, 0x65, 0x6c, 0x3a,
+	0x3a, 0x57, 0x69, 0x64, 0x67, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hyprpanel_widget_v1_widget_proto_rawDescOnce sync.Once
+	file_hyprpanel_widget_v1_widget_proto_rawDescData = file_hyprpanel_widget_v1_widget_proto_rawDesc
+)
+
+func file_hyprpanel_widget_v1_widget_proto_rawDescGZIP() []byte {
+	file_hyprpanel_widget_v1_widget_proto_rawDescOnce.Do(func() {
+		file_hyprpanel_widget_v1_widget_proto_rawDescData = protoimpl.X.CompressGZIP(file_hyprpanel_widget_v1_widget_proto_rawDescData)
+	})
+	return file_hyprpanel_widget_v1_widget_proto_rawDescData
+}
+
+var file_hyprpanel_widget_v1_widget_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_hyprpanel_widget_v1_widget_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_hyprpanel_widget_v1_widget_proto_goTypes = []interface{}{
+	(Orientation)(0), // 0: hyprpanel.widget.v1.Orientation
+	(Button)(0),      // 1: hyprpanel.widget.v1.Button
+	(*Box)(nil),      // 2: hyprpanel.widget.v1.Box
+	(*Label)(nil),    // 3: hyprpanel.widget.v1.Label
+	(*Icon)(nil),     // 4: hyprpanel.widget.v1.Icon
+	(*Progress)(nil), // 5: hyprpanel.widget.v1.Progress
+	(*MenuItem)(nil), // 6: hyprpanel.widget.v1.MenuItem
+	(*Node)(nil),     // 7: hyprpanel.widget.v1.Node
+}
+var file_hyprpanel_widget_v1_widget_proto_depIdxs = []int32{
+	0, // 0: hyprpanel.widget.v1.Box.orientation:type_name -> hyprpanel.widget.v1.Orientation
+	7, // 1: hyprpanel.widget.v1.Box.children:type_name -> hyprpanel.widget.v1.Node
+	6, // 2: hyprpanel.widget.v1.MenuItem.children:type_name -> hyprpanel.widget.v1.MenuItem
+	6, // 3: hyprpanel.widget.v1.Node.menu:type_name -> hyprpanel.widget.v1.MenuItem
+	2, // 4: hyprpanel.widget.v1.Node.box:type_name -> hyprpanel.widget.v1.Box
+	3, // 5: hyprpanel.widget.v1.Node.label:type_name -> hyprpanel.widget.v1.Label
+	4, // 6: hyprpanel.widget.v1.Node.icon:type_name -> hyprpanel.widget.v1.Icon
+	5, // 7: hyprpanel.widget.v1.Node.progress:type_name -> hyprpanel.widget.v1.Progress
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_hyprpanel_widget_v1_widget_proto_init() }
+func file_hyprpanel_widget_v1_widget_proto_init() {
+	if File_hyprpanel_widget_v1_widget_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_hyprpanel_widget_v1_widget_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Box); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_widget_v1_widget_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Label); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_widget_v1_widget_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Icon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_widget_v1_widget_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Progress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_widget_v1_widget_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MenuItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hyprpanel_widget_v1_widget_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_hyprpanel_widget_v1_widget_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*Node_Box)(nil),
+		(*Node_Label)(nil),
+		(*Node_Icon)(nil),
+		(*Node_Progress)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hyprpanel_widget_v1_widget_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hyprpanel_widget_v1_widget_proto_goTypes,
+		DependencyIndexes: file_hyprpanel_widget_v1_widget_proto_depIdxs,
+		EnumInfos:         file_hyprpanel_widget_v1_widget_proto_enumTypes,
+		MessageInfos:      file_hyprpanel_widget_v1_widget_proto_msgTypes,
+	}.Build()
+	File_hyprpanel_widget_v1_widget_proto = out.File
+	file_hyprpanel_widget_v1_widget_proto_rawDesc = nil
+	file_hyprpanel_widget_v1_widget_proto_goTypes = nil
+	file_hyprpanel_widget_v1_widget_proto_depIdxs = nil
+}
@@ -1,4 +1,4 @@
 //go:generate buf generate
 
-// Package proto is for codegen only
+// Package proto is for codegen, and embeds the configuration proto sources
 package proto
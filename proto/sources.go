@@ -0,0 +1,9 @@
+package proto
+
+import "embed"
+
+// Sources holds the configuration proto definitions, for field comments that
+// are not retained in generated descriptors.
+//
+//go:embed hyprpanel/config/v1/config.proto hyprpanel/module/v1/module.proto
+var Sources embed.FS
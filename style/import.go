@@ -0,0 +1,135 @@
+package style
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// importRule matches an @import rule at the start of its input, capturing the
+// target in one of the url("..."), url('...'), url(...), "..." or '...' forms.
+var importRule = regexp.MustCompile(`^@import\s+(?:url\(\s*(?:"([^"]*)"|'([^']*)'|([^)'"\s]*))\s*\)|"([^"]*)"|'([^']*)')[^;]*;`)
+
+// importer reads a stylesheet and the stylesheets it imports.
+type importer struct {
+	// files lists every file read, or attempted.
+	files []string
+	// stack holds the files currently being read, to detect cycles.
+	stack []string
+}
+
+// load reads the stylesheet at path, replacing top-level @import rules for
+// local files with the contents of the imported file.
+func (im *importer) load(path string) ([]byte, error) {
+	path = filepath.Clean(path)
+	if slices.Contains(im.stack, path) {
+		return nil, fmt.Errorf("import cycle: %s", strings.Join(append(slices.Clone(im.stack), path), ` -> `))
+	}
+	im.files = append(im.files, path)
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	im.stack = append(im.stack, path)
+	defer func() {
+		im.stack = im.stack[:len(im.stack)-1]
+	}()
+
+	var (
+		out   bytes.Buffer
+		depth int
+		last  int
+	)
+	for i := 0; i < len(src); {
+		switch {
+		case bytes.HasPrefix(src[i:], []byte(`/*`)):
+			end := bytes.Index(src[i+2:], []byte(`*/`))
+			if end < 0 {
+				i = len(src)
+				continue
+			}
+			i += end + 4
+		case src[i] == '"' || src[i] == '\'':
+			i = skipString(src, i)
+		case src[i] == '{':
+			depth++
+			i++
+		case src[i] == '}':
+			depth--
+			i++
+		case src[i] == '@' && depth == 0:
+			m := importRule.FindSubmatch(src[i:])
+			if m == nil {
+				i++
+				continue
+			}
+			var target string
+			for _, group := range m[1:] {
+				if len(group) > 0 {
+					target = string(group)
+					break
+				}
+			}
+			file, ok := importPath(path, target)
+			if !ok {
+				// Left for GTK to resolve, e.g. resource:// URLs.
+				i += len(m[0])
+				continue
+			}
+			b, err := im.load(file)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			out.Write(src[last:i])
+			out.Write(b)
+			out.WriteByte('\n')
+			i += len(m[0])
+			last = i
+		default:
+			i++
+		}
+	}
+	out.Write(src[last:])
+
+	return out.Bytes(), nil
+}
+
+// skipString returns the offset following the quoted string starting at i.
+func skipString(src []byte, i int) int {
+	quote := src[i]
+	for i++; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			i++
+		case quote, '\n':
+			return i + 1
+		}
+	}
+
+	return i
+}
+
+// importPath resolves the import target against the importing file at path,
+// returning false if target is not a local file.
+func importPath(path, target string) (string, bool) {
+	if target == `` {
+		return ``, false
+	}
+	if u, err := url.Parse(target); err == nil && u.Scheme != `` {
+		if u.Scheme != `file` {
+			return ``, false
+		}
+		return u.Path, true
+	}
+	if filepath.IsAbs(target) {
+		return target, true
+	}
+
+	return filepath.Join(filepath.Dir(path), target), true
+}
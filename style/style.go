@@ -3,7 +3,6 @@ package style
 
 import (
 	_ "embed"
-	"os"
 )
 
 const (
@@ -45,6 +44,10 @@ const (
 	IdleInhibitorID = `idleInhibitor`
 	// MediaPlayerID element identifier.
 	MediaPlayerID = `mediaPlayer`
+	// CustomID element identifier.
+	CustomID = `custom`
+	// WidgetID element identifier.
+	WidgetID = `widget`
 
 	// ModuleClass class name.
 	ModuleClass = `module`
@@ -94,6 +97,14 @@ const (
 	MediaPlayerTitleClass = `mediaPlayerTitle`
 	// MediaPlayerArtistClass class name.
 	MediaPlayerArtistClass = `mediaPlayerArtist`
+	// CustomIconClass class name.
+	CustomIconClass = `customIcon`
+	// CustomLabelClass class name.
+	CustomLabelClass = `customLabel`
+	// CustomGaugeClass class name.
+	CustomGaugeClass = `customGauge`
+	// WidgetNodeClass class name.
+	WidgetNodeClass = `widgetNode`
 
 	// TooltipImageClass class name.
 	TooltipImageClass = `tooltipImage`
@@ -120,11 +131,15 @@ const (
 //go:embed default.css
 var Default []byte
 
-// Load a stylesheet from disk.
-func Load(path string) ([]byte, error) {
-	b, err := os.ReadFile(path)
+// Load a stylesheet from disk. Panels load stylesheets from memory, so the
+// stylesheets it imports with @import are inlined, resolving relative paths
+// against the importing file. The paths of all files read are returned, also on
+// failure, so that they may be watched for changes.
+func Load(path string) ([]byte, []string, error) {
+	im := &importer{}
+	b, err := im.load(path)
 	if err != nil {
-		return nil, err
+		return nil, im.files, err
 	}
-	return b, nil
+	return b, im.files, nil
 }
@@ -0,0 +1,162 @@
+package widget
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/go-plugin"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	widgetv1 "github.com/pdf/hyprpanel/proto/hyprpanel/widget/v1"
+	"google.golang.org/grpc"
+)
+
+// Server serves a Widget to panels, and publishes its tree to every connected
+// panel.
+type Server struct {
+	hyprpanelv1.UnimplementedWidgetServiceServer
+	impl Widget
+
+	mu       sync.Mutex
+	root     *widgetv1.Node
+	watchers map[chan *widgetv1.Node]struct{}
+}
+
+// Publish replaces the widget tree displayed by all panels. The tree must not
+// be modified after publishing, publish a new tree instead. Panels that fall
+// behind receive only the latest tree.
+func (s *Server) Publish(root *widgetv1.Node) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.root = root
+	for ch := range s.watchers {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- root
+	}
+}
+
+// Init implementation.
+func (s *Server) Init(_ context.Context, req *hyprpanelv1.WidgetServiceInitRequest) (*hyprpanelv1.WidgetServiceInitResponse, error) {
+	if err := s.impl.Init(req.PanelId, req.Name, req.Config); err != nil {
+		return &hyprpanelv1.WidgetServiceInitResponse{}, err
+	}
+
+	return &hyprpanelv1.WidgetServiceInitResponse{}, nil
+}
+
+// Watch implementation.
+func (s *Server) Watch(_ *hyprpanelv1.WidgetServiceWatchRequest, stream hyprpanelv1.WidgetService_WatchServer) error {
+	ch := make(chan *widgetv1.Node, 1)
+	s.mu.Lock()
+	if s.root != nil {
+		ch <- s.root
+	}
+	s.watchers[ch] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.watchers, ch)
+		s.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case root := <-ch:
+			if err := stream.Send(&hyprpanelv1.WidgetServiceWatchResponse{Root: root}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Click implementation.
+func (s *Server) Click(_ context.Context, req *hyprpanelv1.WidgetServiceClickRequest) (*hyprpanelv1.WidgetServiceClickResponse, error) {
+	if err := s.impl.Click(req.PanelId, req.NodeId, req.Button); err != nil {
+		return &hyprpanelv1.WidgetServiceClickResponse{}, err
+	}
+
+	return &hyprpanelv1.WidgetServiceClickResponse{}, nil
+}
+
+// Scroll implementation.
+func (s *Server) Scroll(_ context.Context, req *hyprpanelv1.WidgetServiceScrollRequest) (*hyprpanelv1.WidgetServiceScrollResponse, error) {
+	if err := s.impl.Scroll(req.PanelId, req.NodeId, req.Direction); err != nil {
+		return &hyprpanelv1.WidgetServiceScrollResponse{}, err
+	}
+
+	return &hyprpanelv1.WidgetServiceScrollResponse{}, nil
+}
+
+// MenuActivate implementation.
+func (s *Server) MenuActivate(_ context.Context, req *hyprpanelv1.WidgetServiceMenuActivateRequest) (*hyprpanelv1.WidgetServiceMenuActivateResponse, error) {
+	if err := s.impl.MenuActivate(req.PanelId, req.NodeId, req.ItemId); err != nil {
+		return &hyprpanelv1.WidgetServiceMenuActivateResponse{}, err
+	}
+
+	return &hyprpanelv1.WidgetServiceMenuActivateResponse{}, nil
+}
+
+// ServePlugin serves the widget as a plugin executable launched by a panel,
+// blocking until the panel exits. Configure the widget module with `path`
+// to use this mode.
+func (s *Server) ServePlugin() {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins: map[string]plugin.Plugin{
+			PluginName: &Plugin{Impl: s},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
+
+// ServeSocket serves the widget on a unix socket at path, blocking until ctx
+// is cancelled. Configure the widget module with `socket` to use this mode.
+func (s *Server) ServeSocket(ctx context.Context, path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	listener, err := net.Listen(`unix`, path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(path)
+	}()
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = listener.Close()
+		return err
+	}
+
+	server := grpc.NewServer()
+	hyprpanelv1.RegisterWidgetServiceServer(server, s)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	select {
+	case <-ctx.Done():
+		server.Stop()
+		<-errCh
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+// NewServer instantiates a new Server for impl. Call Publish to set the
+// initial tree before serving.
+func NewServer(impl Widget) *Server {
+	return &Server{
+		impl:     impl,
+		watchers: make(map[chan *widgetv1.Node]struct{}),
+	}
+}
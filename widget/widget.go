@@ -0,0 +1,69 @@
+// Package widget provides the SDK for out-of-process panel widgets.
+//
+// A widget publishes a declarative tree of nodes (labels, icons, progress bars
+// and boxes, with tooltips and menus), which the panel renders like a built-in
+// module. Nodes that carry an identifier receive click, scroll and menu
+// callbacks.
+//
+// Widgets are either launched by each panel as a plugin executable, by
+// calling ServePlugin from main, or run independently and serve a unix socket
+// that panels connect to, by calling ServeSocket.
+package widget
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-plugin"
+	eventv1 "github.com/pdf/hyprpanel/proto/hyprpanel/event/v1"
+	hyprpanelv1 "github.com/pdf/hyprpanel/proto/hyprpanel/v1"
+	widgetv1 "github.com/pdf/hyprpanel/proto/hyprpanel/widget/v1"
+	"google.golang.org/grpc"
+)
+
+// Compile-time check
+var _ plugin.GRPCPlugin = &Plugin{}
+
+const (
+	// PluginName constant.
+	PluginName = `widget`
+)
+
+// Handshake default parameters.
+var Handshake = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   `hyprpanel`,
+	MagicCookieValue: `widget`,
+}
+
+// PluginMap default parameters.
+var PluginMap = map[string]plugin.Plugin{
+	PluginName: &Plugin{},
+}
+
+// Widget is implemented by widget authors. Each panel displaying the widget
+// calls Init once it connects, and callbacks identify the panel and node that
+// received input. Methods may be called concurrently.
+type Widget interface {
+	Init(panelID, name string, config map[string]string) error
+	Click(panelID, nodeID string, button widgetv1.Button) error
+	Scroll(panelID, nodeID string, direction eventv1.Direction) error
+	MenuActivate(panelID, nodeID, itemID string) error
+}
+
+// Plugin definition.
+type Plugin struct {
+	plugin.NetRPCUnsupportedPlugin
+
+	Impl hyprpanelv1.WidgetServiceServer
+}
+
+// GRPCServer satisfies the plugin interface.
+func (p *Plugin) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	hyprpanelv1.RegisterWidgetServiceServer(s, p.Impl)
+	return nil
+}
+
+// GRPCClient satisfies the plugin interface.
+func (p *Plugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return hyprpanelv1.NewWidgetServiceClient(c), nil
+}